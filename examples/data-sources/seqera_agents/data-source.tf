@@ -0,0 +1,12 @@
+# Find an agent created in the platform UI and launch it from Terraform.
+data "seqera_agents" "triage" {
+  workspace_id = var.workspace_id
+  search       = "run-triage"
+}
+
+action "seqera_launch_agent" "triage" {
+  config {
+    workspace_id = var.workspace_id
+    agent_id     = data.seqera_agents.triage.agents[0].id
+  }
+}
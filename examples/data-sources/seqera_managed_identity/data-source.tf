@@ -0,0 +1,13 @@
+# Onboard members to a managed identity created in the platform UI.
+data "seqera_managed_identity" "hpc" {
+  org_id = var.org_id
+  name   = "hpc-slurm"
+}
+
+resource "seqera_managed_identity_credential" "bob" {
+  org_id              = var.org_id
+  managed_identity_id = data.seqera_managed_identity.hpc.managed_identity_id
+  user_id             = seqera_organization_member.bob.user_id
+  name                = "bob-hpc"
+  private_key         = var.bob_hpc_private_key
+}
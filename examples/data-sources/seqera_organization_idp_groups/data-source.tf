@@ -0,0 +1,9 @@
+# List the groups the identity provider has provisioned over SCIM.
+data "seqera_organization_idp_groups" "scim" {
+  org_id = var.org_id
+  source = "SCIM"
+}
+
+output "scim_group_names" {
+  value = data.seqera_organization_idp_groups.scim.groups[*].name
+}
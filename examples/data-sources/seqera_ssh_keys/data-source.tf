@@ -0,0 +1,7 @@
+# List the SSH keys of the authenticated user, including keys registered
+# outside Terraform.
+data "seqera_ssh_keys" "mine" {}
+
+output "ssh_key_names" {
+  value = [for k in data.seqera_ssh_keys.mine.keys : k.name]
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_action.my_seqera_action
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_agent.my_seqera_agent
+  identity = {
+    workspace_id = 123
+    id           = "agent-abc123"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = seqera_agent.my_seqera_agent
+  id = "12345/agent-abc123"
+}
@@ -0,0 +1,2 @@
+# Import by workspace_id/agent_id.
+terraform import seqera_agent.my_seqera_agent '12345/agent-abc123'
@@ -0,0 +1,34 @@
+# Keep the agent instructions in version control next to the pipeline.
+resource "seqera_agent" "triage" {
+  workspace_id      = var.workspace_id
+  name              = "run-triage"
+  description       = "Explains failed runs of the RNA-seq pipeline"
+  instructions_file = "${path.module}/agents/run-triage.md"
+}
+
+# Short instructions can be given inline. A disabled agent cannot be launched.
+resource "seqera_agent" "cost_report" {
+  workspace_id = var.workspace_id
+  name         = "cost-report"
+  instructions = "Report the cost of last week's runs, grouped by pipeline."
+  enabled      = false
+}
+
+# Launch the triage agent after each apply that changes its instructions.
+resource "terraform_data" "triage_launch" {
+  triggers_replace = [seqera_agent.triage.instructions]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.seqera_launch_agent.triage]
+    }
+  }
+}
+
+action "seqera_launch_agent" "triage" {
+  config {
+    workspace_id = var.workspace_id
+    agent_id     = seqera_agent.triage.agent_id
+  }
+}
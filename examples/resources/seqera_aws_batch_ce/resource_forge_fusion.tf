@@ -35,4 +35,11 @@ resource "seqera_aws_batch_ce" "forge_fusion" {
       ]
     }
   }
+
+  # Forge provisions the Batch queues and IAM roles before the CE turns
+  # AVAILABLE, which can outlast the 5m default on a busy account.
+  timeouts {
+    create = "20m"
+    delete = "20m"
+  }
 }
@@ -0,0 +1,7 @@
+import {
+  to = seqera_aws_cloud_ce.my_seqera_aws_cloud_ce
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_aws_credential.my_seqera_aws_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
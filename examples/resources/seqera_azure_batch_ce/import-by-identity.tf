@@ -0,0 +1,7 @@
+import {
+  to = seqera_azure_batch_ce.my_seqera_azure_batch_ce
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_azure_entra_credential.my_seqera_azure_entra_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_bitbucket_credential.my_seqera_bitbucket_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_codecommit_credential.my_seqera_codecommit_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
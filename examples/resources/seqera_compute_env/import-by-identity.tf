@@ -0,0 +1,7 @@
+import {
+  to = seqera_compute_env.my_seqera_compute_env
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
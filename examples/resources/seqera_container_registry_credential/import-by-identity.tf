@@ -0,0 +1,7 @@
+import {
+  to = seqera_container_registry_credential.my_seqera_container_registry_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
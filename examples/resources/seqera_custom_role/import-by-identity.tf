@@ -0,0 +1,7 @@
+import {
+  to = seqera_custom_role.my_seqera_custom_role
+  identity = {
+    org_id = 123
+    id     = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_data_link.my_seqera_data_link
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = seqera_dataset_version.my_seqera_dataset_version
+  identity = {
+    workspace_id = 123
+    dataset_id   = "..."
+    version      = 123
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_datasets.my_seqera_datasets
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = seqera_datasets.my_seqera_datasets
+  id = "12345/samplesheet"
+}
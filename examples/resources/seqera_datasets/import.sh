@@ -0,0 +1,2 @@
+# Import by workspace ID and dataset ID or name.
+terraform import seqera_datasets.my_seqera_datasets '12345/samplesheet'
@@ -0,0 +1,7 @@
+import {
+  to = seqera_gcp_batch_ce.my_seqera_gcp_batch_ce
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
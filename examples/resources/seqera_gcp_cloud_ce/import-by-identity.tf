@@ -0,0 +1,7 @@
+import {
+  to = seqera_gcp_cloud_ce.my_seqera_gcp_cloud_ce
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
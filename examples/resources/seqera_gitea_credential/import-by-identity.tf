@@ -0,0 +1,7 @@
+import {
+  to = seqera_gitea_credential.my_seqera_gitea_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_github_credential.my_seqera_github_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_gitlab_credential.my_seqera_gitlab_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
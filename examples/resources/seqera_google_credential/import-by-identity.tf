@@ -0,0 +1,7 @@
+import {
+  to = seqera_google_credential.my_seqera_google_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
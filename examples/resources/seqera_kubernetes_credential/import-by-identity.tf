@@ -0,0 +1,7 @@
+import {
+  to = seqera_kubernetes_credential.my_seqera_kubernetes_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
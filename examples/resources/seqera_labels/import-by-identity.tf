@@ -0,0 +1,7 @@
+import {
+  to = seqera_labels.my_seqera_labels
+  identity = {
+    workspace_id = 123
+    id           = 123
+  }
+}
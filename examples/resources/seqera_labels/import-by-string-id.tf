@@ -0,0 +1,4 @@
+import {
+  to = seqera_labels.my_seqera_labels
+  id = "12345/environment=production"
+}
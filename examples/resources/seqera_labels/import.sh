@@ -0,0 +1,5 @@
+# Import a simple label by workspace ID and name.
+terraform import seqera_labels.my_seqera_labels '12345/experimental'
+
+# Import a resource label by workspace ID, name and value.
+terraform import seqera_labels.my_seqera_labels '12345/environment=production'
@@ -0,0 +1,7 @@
+import {
+  to = seqera_managed_compute_ce.my_seqera_managed_compute_ce
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
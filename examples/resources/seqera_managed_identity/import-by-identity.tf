@@ -0,0 +1,7 @@
+import {
+  to = seqera_managed_identity.my_seqera_managed_identity
+  identity = {
+    org_id = 12345
+    id     = 67
+  }
+}
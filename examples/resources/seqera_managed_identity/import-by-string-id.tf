@@ -0,0 +1,4 @@
+import {
+  to = seqera_managed_identity.my_seqera_managed_identity
+  id = "12345/67"
+}
@@ -0,0 +1,2 @@
+# Import by org_id/managed_identity_id.
+terraform import seqera_managed_identity.my_seqera_managed_identity '12345/67'
@@ -0,0 +1,10 @@
+# A Slurm cluster that members launch pipelines on under their own account.
+resource "seqera_managed_identity" "hpc" {
+  org_id        = var.org_id
+  name          = "hpc-slurm"
+  platform      = "slurm-platform"
+  host_name     = "login.hpc.example.com"
+  head_queue    = "long"
+  compute_queue = "normal"
+  work_dir      = "/scratch/nextflow/work"
+}
@@ -0,0 +1,8 @@
+import {
+  to = seqera_managed_identity_credential.my_seqera_managed_identity_credential
+  identity = {
+    org_id              = 12345
+    managed_identity_id = 67
+    id                  = 89
+  }
+}
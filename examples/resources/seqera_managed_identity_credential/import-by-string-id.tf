@@ -0,0 +1,4 @@
+import {
+  to = seqera_managed_identity_credential.my_seqera_managed_identity_credential
+  id = "12345/67/89"
+}
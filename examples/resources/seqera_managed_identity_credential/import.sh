@@ -0,0 +1,2 @@
+# Import by org_id/managed_identity_id/managed_credentials_id.
+terraform import seqera_managed_identity_credential.my_seqera_managed_identity_credential '12345/67/89'
@@ -0,0 +1,13 @@
+# Onboard a new member to the shared Slurm cluster with their own SSH key.
+resource "seqera_organization_member" "alice" {
+  org_id = var.org_id
+  email  = "alice@example.com"
+}
+
+resource "seqera_managed_identity_credential" "alice" {
+  org_id              = var.org_id
+  managed_identity_id = seqera_managed_identity.hpc.managed_identity_id
+  user_id             = seqera_organization_member.alice.user_id
+  name                = "alice-hpc"
+  private_key         = var.alice_hpc_private_key
+}
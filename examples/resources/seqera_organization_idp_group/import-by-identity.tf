@@ -0,0 +1,7 @@
+import {
+  to = seqera_organization_idp_group.my_seqera_organization_idp_group
+  identity = {
+    org_id = 12345
+    id     = 67
+  }
+}
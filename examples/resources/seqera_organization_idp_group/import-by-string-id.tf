@@ -0,0 +1,4 @@
+import {
+  to = seqera_organization_idp_group.my_seqera_organization_idp_group
+  id = "12345/67"
+}
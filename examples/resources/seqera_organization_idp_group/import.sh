@@ -0,0 +1,2 @@
+# Import by org_id/group_id.
+terraform import seqera_organization_idp_group.my_seqera_organization_idp_group '12345/67'
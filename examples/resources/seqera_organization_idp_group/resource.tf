@@ -0,0 +1,10 @@
+# Register the SSO groups that teams take their members from.
+resource "seqera_organization_idp_group" "bioinformatics" {
+  org_id = var.org_id
+  name   = "okta-bioinformatics"
+}
+
+resource "seqera_organization_idp_group" "platform_admins" {
+  org_id = var.org_id
+  name   = "okta-platform-admins"
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_organization_member.my_seqera_organization_member
+  identity = {
+    org_id = 123
+    email  = "user@example.com"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = seqera_organization_scim.my_seqera_organization_scim
+  identity = {
+    org_id = 12345
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = seqera_organization_scim.my_seqera_organization_scim
+  id = "12345"
+}
@@ -0,0 +1,2 @@
+# Import by org_id. The token is not known until the next rotation.
+terraform import seqera_organization_scim.my_seqera_organization_scim '12345'
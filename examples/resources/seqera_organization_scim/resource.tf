@@ -0,0 +1,21 @@
+# Rotate the SCIM token every 90 days and keep the current token in a secret
+# the identity provider integration reads from.
+resource "seqera_organization_scim" "main" {
+  org_id            = var.org_id
+  rotate_after_days = 90
+}
+
+resource "aws_secretsmanager_secret_version" "scim_token" {
+  secret_id     = aws_secretsmanager_secret.scim_token.id
+  secret_string = seqera_organization_scim.main.token
+}
+
+# Alternatively, rotate whenever a time_rotating resource rolls over.
+resource "time_rotating" "scim" {
+  rotation_days = 90
+}
+
+resource "seqera_organization_scim" "other" {
+  org_id           = var.other_org_id
+  rotation_trigger = time_rotating.scim.id
+}
@@ -0,0 +1,6 @@
+import {
+  to = seqera_orgs.my_seqera_orgs
+  identity = {
+    id = 123
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_pipeline.my_seqera_pipeline
+  identity = {
+    workspace_id = 123
+    id           = 123
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_pipeline_secret.my_seqera_pipeline_secret
+  identity = {
+    workspace_id = 123
+    id           = 123
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_slurm_ce.my_seqera_slurm_ce
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
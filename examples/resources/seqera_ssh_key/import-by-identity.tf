@@ -0,0 +1,6 @@
+import {
+  to = seqera_ssh_key.my_seqera_ssh_key
+  identity = {
+    id = 123
+  }
+}
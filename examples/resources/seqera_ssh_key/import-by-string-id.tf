@@ -0,0 +1,4 @@
+import {
+  to = seqera_ssh_key.my_seqera_ssh_key
+  id = "laptop"
+}
@@ -0,0 +1,2 @@
+# Import by key name or ID.
+terraform import seqera_ssh_key.my_seqera_ssh_key 'laptop'
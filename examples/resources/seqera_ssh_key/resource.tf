@@ -0,0 +1,5 @@
+# Register a public key so it can connect to studios with ssh_enabled = true.
+resource "seqera_ssh_key" "laptop" {
+  name       = "laptop"
+  public_key = trimspace(file("~/.ssh/id_ed25519.pub"))
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_studios.my_seqera_studios
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
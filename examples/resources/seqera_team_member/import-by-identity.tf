@@ -0,0 +1,8 @@
+import {
+  to = seqera_team_member.my_seqera_team_member
+  identity = {
+    org_id  = 123
+    team_id = 123
+    email   = "user@example.com"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_teams.my_seqera_teams
+  identity = {
+    org_id = 123
+    id     = 123
+  }
+}
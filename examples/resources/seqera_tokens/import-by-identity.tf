@@ -0,0 +1,6 @@
+import {
+  to = seqera_tokens.my_seqera_tokens
+  identity = {
+    id = 123
+  }
+}
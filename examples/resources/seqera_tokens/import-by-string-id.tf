@@ -0,0 +1,4 @@
+import {
+  to = seqera_tokens.my_seqera_tokens
+  id = "ci-pipeline"
+}
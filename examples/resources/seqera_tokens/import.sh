@@ -0,0 +1,3 @@
+# Import by token name or ID. The access_key is not returned by the
+# platform after creation and stays null.
+terraform import seqera_tokens.my_seqera_tokens 'ci-pipeline'
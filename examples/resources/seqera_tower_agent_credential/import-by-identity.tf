@@ -0,0 +1,7 @@
+import {
+  to = seqera_tower_agent_credential.my_seqera_tower_agent_credential
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
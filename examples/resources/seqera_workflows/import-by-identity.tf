@@ -0,0 +1,7 @@
+import {
+  to = seqera_workflows.my_seqera_workflows
+  identity = {
+    workspace_id = 123
+    id           = "..."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = seqera_workspace.my_seqera_workspace
+  identity = {
+    org_id = 123
+    id     = 123
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = seqera_workspace_participant.my_seqera_workspace_participant
+  identity = {
+    org_id       = 123
+    workspace_id = 123
+    email        = "user@example.com"
+  }
+}
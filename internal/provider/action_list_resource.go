@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &ActionListResource{}
+	_ list.ListResourceWithConfigure = &ActionListResource{}
+)
+
+func NewActionListResource() list.ListResource {
+	return &ActionListResource{}
+}
+
+// ActionListResource lists the pipeline actions of a workspace.
+type ActionListResource struct {
+	client *sdk.Seqera
+}
+
+type ActionListResourceModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	Name        types.String `tfsdk:"name"`
+	Source      types.String `tfsdk:"source"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func (r *ActionListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action"
+}
+
+func (r *ActionListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the pipeline actions of a workspace, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_action`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every action found:
+
+` + "```hcl" + `
+list "seqera_action" "webhooks" {
+  provider = seqera
+
+  config {
+    source = "tower"
+    status = "ACTIVE"
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": common.ListWorkspaceAttribute(),
+			"name":         common.ListNameAttribute(),
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list actions triggered by this source; must be one of ["github", "tower", "bucket", "cron"]`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(shared.ActionSourceGithub),
+						string(shared.ActionSourceTower),
+						string(shared.ActionSourceBucket),
+						string(shared.ActionSourceCron),
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list actions with this status; must be one of ["CREATING", "ACTIVE", "ERROR", "PAUSED"]`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(shared.ActionStatusCreating),
+						string(shared.ActionStatusActive),
+						string(shared.ActionStatusError),
+						string(shared.ActionStatusPaused),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *ActionListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *ActionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ActionListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	workspaceID, workspaceDiags := common.ListWorkspace(r.client, data.WorkspaceID)
+	diags.Append(workspaceDiags...)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	matchSource := common.StringFilter(data.Source)
+	matchStatus := common.StringFilter(data.Status)
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		res, err := r.client.Actions.ListActions(ctx, operations.ListActionsRequest{
+			WorkspaceID: &workspaceID,
+		})
+		if err != nil {
+			return fmt.Errorf("listing actions: %w", err)
+		}
+		if res.StatusCode != http.StatusOK || res.ListActionsResponse == nil {
+			return common.UnexpectedStatusErr(ctx, "listing actions", res.RawResponse)
+		}
+		for _, action := range res.ListActionsResponse.Actions {
+			if action.ID == nil || action.Name == nil || !matchName(*action.Name) ||
+				!matchSource((*string)(action.Source)) || !matchStatus((*string)(action.Status)) {
+				continue
+			}
+			if !yield(common.ListItem{
+				DisplayName: *action.Name,
+				Attributes: map[string]attr.Value{
+					"workspace_id": types.Int64Value(workspaceID),
+					"action_id":    types.StringValue(*action.ID),
+				},
+			}) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	read, diags := newConfiguredResource(ctx, NewActionResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, actionIdentity, walk, read.Read)
+}
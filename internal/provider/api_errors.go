@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	sdkerrors "github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/errors"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// API failures in the generated resources and data sources are reported
+// through common.AddUnexpectedStatus, so that platform errors about a field
+// land on the matching attribute and the request/response dump stays in the
+// debug log. schemaType is the type of the request's plan, state or config.
+
+// reportAPIErrors rewrites the diagnostics of a generated operation, which
+// report a failed call with err.Error() and an unredacted request/response
+// dump, into the ones above. last is the response of the operation's last
+// API call, recorded through package responses, or nil if it got none.
+func reportAPIErrors(ctx context.Context, diags diag.Diagnostics, schemaType tftypes.Type, last *http.Response) diag.Diagnostics {
+	var reported diag.Diagnostics
+	for _, d := range diags {
+		summary := d.Summary()
+		switch {
+		case summary == "unexpected http request/response":
+			// The dump of a call reported by the cases below.
+		case summary == "failure to invoke API" && last != nil && last.StatusCode >= 400:
+			addUnexpectedResponse(ctx, &reported, schemaType, last)
+		case summary == "failure to invoke API" && last != nil:
+			// A response the SDK could not decode: err.Error() ends with
+			// its unredacted body, which the debug log holds redacted.
+			message, _, _ := strings.Cut(d.Detail(), "\n")
+			reported.AddError(summary, message)
+		case strings.HasPrefix(summary, "unexpected response from API. Got an unexpected response code") && last != nil:
+			addUnexpectedResponse(ctx, &reported, schemaType, last)
+		case summary == "unexpected response from API. Got an unexpected response body" && last != nil:
+			reported.AddError(summary, common.DebugResponse(last))
+		default:
+			reported.Append(d)
+		}
+	}
+	return reported
+}
+
+// addUnexpectedResponse reports a response whose status code the operation
+// does not handle.
+func addUnexpectedResponse(ctx context.Context, diags *diag.Diagnostics, schemaType tftypes.Type, res *http.Response) {
+	action := "calling the API"
+	if res.Request != nil {
+		action = "calling " + res.Request.Method + " " + res.Request.URL.Path
+	}
+	common.AddUnexpectedStatus(ctx, diags, action, res, schemaType)
+}
+
+// addInvokeError reports a failed API call. Status codes the SDK does not
+// declare for the operation come back as an *errors.APIError holding the
+// response, and are reported like any other unexpected response.
+func addInvokeError(ctx context.Context, diags *diag.Diagnostics, schemaType tftypes.Type, err error) {
+	var apiErr *sdkerrors.APIError
+	if errors.As(err, &apiErr) && apiErr.RawResponse != nil {
+		addUnexpectedResponse(ctx, diags, schemaType, apiErr.RawResponse)
+		return
+	}
+	diags.AddError("failure to invoke API", err.Error())
+}
+
+// unexpectedResponseErr returns an error for a response the lookups in
+// resource_import.go do not handle. It holds the response, so that
+// addInvokeError reports it like an unexpected response of a generated
+// operation.
+func unexpectedResponseErr(ctx context.Context, action string, res *http.Response) error {
+	if res == nil {
+		return common.UnexpectedStatusErr(ctx, action, res)
+	}
+	return fmt.Errorf("%s: %w", action, sdkerrors.NewAPIError(common.ParseAPIError(res).String(), res.StatusCode, "", res))
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &ComputeEnvListResource{}
+	_ list.ListResourceWithConfigure = &ComputeEnvListResource{}
+)
+
+func NewComputeEnvListResource() list.ListResource {
+	return &ComputeEnvListResource{}
+}
+
+// ComputeEnvListResource lists the compute environments of a workspace.
+type ComputeEnvListResource struct {
+	client *sdk.Seqera
+}
+
+type ComputeEnvListResourceModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	Name        types.String `tfsdk:"name"`
+	Platform    types.String `tfsdk:"platform"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func (r *ComputeEnvListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_env"
+}
+
+func (r *ComputeEnvListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the compute environments of a workspace, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_compute_env`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every compute environment found:
+
+` + "```hcl" + `
+list "seqera_compute_env" "aws" {
+  provider = seqera
+
+  config {
+    platform = "aws-batch"
+    status   = "AVAILABLE"
+  }
+}
+` + "```" + `
+
+Results are always ` + "`" + `seqera_compute_env` + "`" + ` resources, including compute environments managed with a platform-specific resource such as ` + "`" + `seqera_aws_batch_ce` + "`" + `, which has no list resource of its own. Import those by ID instead. The platform returns every compute environment of the workspace in one response, so the list is not paginated.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": common.ListWorkspaceAttribute(),
+			"name":         common.ListNameAttribute(),
+			"platform": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list compute environments of this platform, such as "aws-batch" or "google-batch".`,
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list compute environments with this status; must be one of ["CREATING", "AVAILABLE", "ERRORED", "INVALID", "DISABLED"]`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(shared.ComputeEnvStatusCreating),
+						string(shared.ComputeEnvStatusAvailable),
+						string(shared.ComputeEnvStatusErrored),
+						string(shared.ComputeEnvStatusInvalid),
+						string(shared.ComputeEnvStatusDisabled),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *ComputeEnvListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *ComputeEnvListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ComputeEnvListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	workspaceID, workspaceDiags := common.ListWorkspace(r.client, data.WorkspaceID)
+	diags.Append(workspaceDiags...)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	matchPlatform := common.StringFilter(data.Platform)
+
+	// GET /compute-envs takes no max or offset and returns every compute
+	// environment of the workspace, so unlike the other list resources there
+	// is nothing to page through with common.PaginatedSearch.
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		res, err := r.client.ComputeEnvs.ListComputeEnvs(ctx, operations.ListComputeEnvsRequest{
+			WorkspaceID: &workspaceID,
+			Status:      data.Status.ValueStringPointer(),
+		})
+		if err != nil {
+			return fmt.Errorf("listing compute environments: %w", err)
+		}
+		if res.StatusCode != http.StatusOK || res.ListComputeEnvsResponse == nil {
+			return common.UnexpectedStatusErr(ctx, "listing compute environments", res.RawResponse)
+		}
+		for _, ce := range res.ListComputeEnvsResponse.ComputeEnvs {
+			if ce.ID == nil || ce.Name == nil || !matchName(*ce.Name) || !matchPlatform(ce.Platform) {
+				continue
+			}
+			if !yield(common.ListItem{
+				DisplayName: *ce.Name,
+				Attributes: map[string]attr.Value{
+					"workspace_id":   types.Int64Value(workspaceID),
+					"compute_env_id": types.StringValue(*ce.ID),
+				},
+			}) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	read, diags := newConfiguredResource(ctx, NewComputeEnvResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, computeEnvIdentity, walk, read.Read)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/polling"
+)
+
+// Compute environment create and delete are asynchronous: the SDK hook
+// (internal/sdk/internal/hooks/compute_env_status_hook.go) polls until the CE
+// is AVAILABLE or gone. These defaults match the hook's own, so omitting the
+// `timeouts` block keeps the historical behaviour.
+const (
+	computeEnvDefaultCreateTimeout = 5 * time.Minute
+	computeEnvDefaultDeleteTimeout = 5 * time.Minute
+
+	// The poll interval scales with the deadline so that a 2h Forge create
+	// doesn't describe the CE every 10 seconds, bounded on both ends.
+	computeEnvMinPollInterval = 10 * time.Second
+	computeEnvMaxPollInterval = 1 * time.Minute
+	computeEnvPollsPerTimeout = 30
+)
+
+// computeEnvTimeoutsBlock is the `timeouts { create, delete }` block shared by
+// every compute environment resource.
+func computeEnvTimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Delete:            true,
+		CreateDescription: "How long to wait for the compute environment to become AVAILABLE, as a duration string such as \"30m\". Defaults to \"5m\". The status poll interval scales with this value, between 10s and 1m.",
+		DeleteDescription: "How long to wait for the compute environment to be removed, as a duration string such as \"30m\". Defaults to \"5m\". The status poll interval scales with this value, between 10s and 1m.",
+	})
+}
+
+// computeEnvPollingContext attaches the polling deadline and interval for
+// timeout to ctx, for the compute environment status hook to pick up.
+func computeEnvPollingContext(ctx context.Context, timeout time.Duration) context.Context {
+	interval := timeout / computeEnvPollsPerTimeout
+	if interval < computeEnvMinPollInterval {
+		interval = computeEnvMinPollInterval
+	}
+	if interval > computeEnvMaxPollInterval {
+		interval = computeEnvMaxPollInterval
+	}
+	return polling.WithOptions(ctx, polling.Options{
+		Timeout:  timeout,
+		Interval: interval,
+	})
+}
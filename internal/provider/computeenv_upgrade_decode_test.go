@@ -25,10 +25,10 @@ var computeEnvUpgraders = map[string]func(context.Context, resource.UpgradeState
 
 // runUpgraderAgainstSchema runs an upgrader over priorState and returns the
 // upgraded state decoded as a tfsdk.State against the current schema of the
-// resource built by newResource. The decode uses the framework's own strict
-// DynamicValue.Unmarshal (no IgnoreUndefinedAttributes) — the exact check that
-// rejected old state before this fix — so any attribute the upgrader failed to
-// drop fails the test here.
+// resource built by newResource, as the provider extends it. The decode uses
+// the framework's own strict DynamicValue.Unmarshal (no
+// IgnoreUndefinedAttributes) — the exact check that rejected old state before
+// this fix — so any attribute the upgrader failed to drop fails the test here.
 func runUpgraderAgainstSchema(
 	t *testing.T,
 	newResource func() resource.Resource,
@@ -39,7 +39,7 @@ func runUpgraderAgainstSchema(
 	ctx := context.Background()
 
 	schemaResp := &resource.SchemaResponse{}
-	newResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	extendResource(newResource()).Schema(ctx, resource.SchemaRequest{}, schemaResp)
 	if schemaResp.Diagnostics.HasError() {
 		t.Fatalf("schema errors: %v", schemaResp.Diagnostics)
 	}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &CredentialListResource{}
+	_ list.ListResourceWithConfigure = &CredentialListResource{}
+)
+
+func NewCredentialListResource() list.ListResource {
+	return &CredentialListResource{}
+}
+
+// CredentialListResource lists the credentials of a workspace.
+type CredentialListResource struct {
+	client *sdk.Seqera
+}
+
+type CredentialListResourceModel struct {
+	WorkspaceID  types.Int64  `tfsdk:"workspace_id"`
+	Name         types.String `tfsdk:"name"`
+	ProviderType types.String `tfsdk:"provider_type"`
+}
+
+func (r *CredentialListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+func (r *CredentialListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the credentials of a workspace, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_credential`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every credential found. Secret values are never returned by the platform, so the generated configuration needs them filled in:
+
+` + "```hcl" + `
+list "seqera_credential" "github" {
+  provider = seqera
+
+  config {
+    provider_type = "github"
+  }
+}
+` + "```" + `
+
+Results are always ` + "`" + `seqera_credential` + "`" + ` resources, including credentials managed with a provider-specific resource such as ` + "`" + `seqera_aws_credential` + "`" + `, which has no list resource of its own. Import those by ID instead.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": common.ListWorkspaceAttribute(),
+			"name":         common.ListNameAttribute(),
+			"provider_type": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list credentials of this provider type, such as "aws" or "github".`,
+			},
+		},
+	}
+}
+
+func (r *CredentialListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *CredentialListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data CredentialListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	workspaceID, workspaceDiags := common.ListWorkspace(r.client, data.WorkspaceID)
+	diags.Append(workspaceDiags...)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	matchProvider := common.StringFilter(data.ProviderType)
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		res, err := r.client.Credentials.ListCredentials(ctx, operations.ListCredentialsDataSourceRequest{
+			WorkspaceID: &workspaceID,
+		})
+		if err != nil {
+			return fmt.Errorf("listing credentials: %w", err)
+		}
+		if res.StatusCode != http.StatusOK || res.ListCredentialsDataSourceResponse == nil {
+			return common.UnexpectedStatusErr(ctx, "listing credentials", res.RawResponse)
+		}
+		for _, credential := range res.ListCredentialsDataSourceResponse.Credentials {
+			if credential.ID == nil || credential.Name == nil || !matchName(*credential.Name) || !matchProvider(credential.Provider) {
+				continue
+			}
+			if !yield(common.ListItem{
+				DisplayName: *credential.Name,
+				Attributes: map[string]attr.Value{
+					"workspace_id":   types.Int64Value(workspaceID),
+					"credentials_id": types.StringValue(*credential.ID),
+				},
+			}) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	read, diags := newConfiguredResource(ctx, NewCredentialResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, credentialIdentity, walk, read.Read)
+}
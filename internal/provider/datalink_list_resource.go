@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &DataLinkListResource{}
+	_ list.ListResourceWithConfigure = &DataLinkListResource{}
+)
+
+func NewDataLinkListResource() list.ListResource {
+	return &DataLinkListResource{}
+}
+
+// DataLinkListResource lists the data links of a workspace.
+type DataLinkListResource struct {
+	client *sdk.Seqera
+}
+
+type DataLinkListResourceModel struct {
+	WorkspaceID  types.Int64  `tfsdk:"workspace_id"`
+	Name         types.String `tfsdk:"name"`
+	ProviderType types.String `tfsdk:"provider_type"`
+}
+
+func (r *DataLinkListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_link"
+}
+
+func (r *DataLinkListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the data links of a workspace, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_data_link`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every data link found. Data links the platform discovers from credentials are listed too; only import those you intend to manage:
+
+` + "```hcl" + `
+list "seqera_data_link" "s3" {
+  provider = seqera
+
+  config {
+    provider_type = "aws"
+    name          = "results-*"
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": common.ListWorkspaceAttribute(),
+			"name":         common.ListNameAttribute(),
+			"provider_type": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list data links of this provider type, such as "aws" or "google".`,
+			},
+		},
+	}
+}
+
+func (r *DataLinkListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *DataLinkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data DataLinkListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	workspaceID, workspaceDiags := common.ListWorkspace(r.client, data.WorkspaceID)
+	diags.Append(workspaceDiags...)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	matchProvider := common.StringFilter(data.ProviderType)
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		res, err := r.client.DataLinks.ListDataLinks(ctx, operations.ListDataLinksDataSourceRequest{
+			WorkspaceID: &workspaceID,
+		})
+		if err != nil {
+			return fmt.Errorf("listing data links: %w", err)
+		}
+		if res.StatusCode != http.StatusOK || res.ListDataLinksDataSourceResponse == nil {
+			return common.UnexpectedStatusErr(ctx, "listing data links", res.RawResponse)
+		}
+		for _, link := range res.ListDataLinksDataSourceResponse.DataLinks {
+			if link.ID == nil || link.Name == nil || !matchName(*link.Name) || !matchProvider(link.Provider) {
+				continue
+			}
+			if !yield(common.ListItem{
+				DisplayName: *link.Name,
+				Attributes: map[string]attr.Value{
+					"workspace_id": types.Int64Value(workspaceID),
+					"data_link_id": types.StringValue(*link.ID),
+				},
+			}) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	read, diags := newConfiguredResource(ctx, NewDataLinkResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, dataLinkIdentity, walk, read.Read)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// Provider default_labels for the generated labelable resources. The labels
+// are attached through the Labels service once the resource is saved, and are
+// not read back into label_ids, so they never show up as a plan diff.
+
+// defaultLabelTarget describes where a labelable resource keeps the
+// attributes the default labels depend on.
+type defaultLabelTarget struct {
+	target common.LabelTarget
+
+	// id is the path of the String or Int64 attribute holding the ID the
+	// Labels service knows the resource by.
+	id path.Path
+
+	// labelIDs is the path of the list of label IDs the configuration sets
+	// itself, or empty if the resource has none.
+	labelIDs path.Path
+}
+
+func ignoreDefaultLabelsAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Description: `Do not attach the provider default_labels to this resource. Setting it on an existing resource detaches the default labels that label_ids does not list.`,
+	}
+}
+
+// attachDefaultLabels attaches the default labels to a newly created
+// resource. Failures are warnings: the resource itself exists, and failing
+// the apply would taint it.
+func attachDefaultLabels(ctx context.Context, client *sdk.Seqera, workspaceID types.Int64, ignore types.Bool, target common.LabelTarget, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if ignore.ValueBool() {
+		return diags
+	}
+	if err := common.AttachDefaultLabels(ctx, client, workspaceID.ValueInt64(), target, id); err != nil {
+		diags.AddWarning(
+			"Unable to Attach Default Labels",
+			"The resource was saved without the provider default_labels: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// updateDefaultLabels re-attaches the default labels after an update, which
+// may have replaced the resource's labels, or detaches them when the
+// resource opts out. explicit lists the label IDs the configuration sets
+// itself, which are never detached.
+func updateDefaultLabels(ctx context.Context, client *sdk.Seqera, req resource.UpdateRequest, workspaceID types.Int64, ignore types.Bool, target common.LabelTarget, id string, explicit []types.Int64) diag.Diagnostics {
+	if !ignore.ValueBool() {
+		return attachDefaultLabels(ctx, client, workspaceID, ignore, target, id)
+	}
+
+	var diags diag.Diagnostics
+	var ignoredBefore types.Bool
+	diags.Append(req.State.GetAttribute(ctx, path.Root("ignore_default_labels"), &ignoredBefore)...)
+	if diags.HasError() || ignoredBefore.ValueBool() {
+		return diags
+	}
+
+	keep := make([]int64, 0, len(explicit))
+	for _, labelID := range explicit {
+		keep = append(keep, labelID.ValueInt64())
+	}
+	if err := common.DetachDefaultLabels(ctx, client, workspaceID.ValueInt64(), target, id, keep); err != nil {
+		diags.AddWarning(
+			"Unable to Detach Default Labels",
+			"The provider default_labels are still attached to the resource: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// attach attaches the default labels to the resource created with state.
+func (t *defaultLabelTarget) attach(ctx context.Context, client *sdk.Seqera, state tfsdk.State) diag.Diagnostics {
+	workspaceID, ignore, id, diags := t.attributes(ctx, state)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(attachDefaultLabels(ctx, client, workspaceID, ignore, t.target, id)...)
+	return diags
+}
+
+// update re-attaches or detaches the default labels of the resource updated
+// by req to state.
+func (t *defaultLabelTarget) update(ctx context.Context, client *sdk.Seqera, req resource.UpdateRequest, state tfsdk.State) diag.Diagnostics {
+	workspaceID, ignore, id, diags := t.attributes(ctx, state)
+	var explicit []types.Int64
+	if !t.labelIDs.Equal(path.Empty()) {
+		var labelIDs types.List
+		diags.Append(state.GetAttribute(ctx, t.labelIDs, &labelIDs)...)
+		if !labelIDs.IsNull() && !labelIDs.IsUnknown() {
+			diags.Append(labelIDs.ElementsAs(ctx, &explicit, false)...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(updateDefaultLabels(ctx, client, req, workspaceID, ignore, t.target, id, explicit)...)
+	return diags
+}
+
+func (t *defaultLabelTarget) attributes(ctx context.Context, state tfsdk.State) (types.Int64, types.Bool, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var workspaceID types.Int64
+	var ignore types.Bool
+	var id attr.Value
+	diags.Append(state.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("ignore_default_labels"), &ignore)...)
+	diags.Append(state.GetAttribute(ctx, t.id, &id)...)
+
+	switch id := id.(type) {
+	case types.String:
+		return workspaceID, ignore, id.ValueString(), diags
+	case types.Int64:
+		return workspaceID, ignore, strconv.FormatInt(id.ValueInt64(), 10), diags
+	}
+	return workspaceID, ignore, "", diags
+}
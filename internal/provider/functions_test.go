@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func callFunction(t *testing.T, name string, resultType tftypes.Type, args ...tftypes.Value) (tftypes.Value, *tfprotov6.FunctionError) {
+	t.Helper()
+	server, err := providerserver.NewProtocol6WithError(NewExtended("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	functions, err := server.GetFunctions(context.Background(), &tfprotov6.GetFunctionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parameters := functions.Functions[name].Parameters
+	arguments := make([]*tfprotov6.DynamicValue, len(args))
+	for i, arg := range args {
+		value, err := tfprotov6.NewDynamicValue(parameters[i].Type, arg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		arguments[i] = &value
+	}
+	resp, err := server.CallFunction(context.Background(), &tfprotov6.CallFunctionRequest{Name: name, Arguments: arguments})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error
+	}
+	result, err := resp.Result.Unmarshal(resultType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result, nil
+}
+
+func TestValidateWorkDirFunction(t *testing.T) {
+	for _, tc := range []struct {
+		platform, path string
+		want           bool
+	}{
+		{"aws-batch", "s3://bucket/work", true},
+		{"aws-batch", "/efs/work", true},
+		{"aws-cloud", "/efs/work", false},
+		{"aws-batch", "gs://bucket/work", false},
+		{"google-batch", "gs://bucket/work/", false},
+		{"slurm-platform", "/scratch/work", true},
+		{"slurm-platform", "s3://bucket/work", false},
+	} {
+		result, ferr := callFunction(t, "validate_work_dir", tftypes.Bool,
+			tftypes.NewValue(tftypes.String, tc.platform),
+			tftypes.NewValue(tftypes.String, tc.path))
+		if ferr != nil {
+			t.Fatalf("%s %s: %s", tc.platform, tc.path, ferr.Text)
+		}
+		var got bool
+		_ = result.As(&got)
+		if got != tc.want {
+			t.Errorf("%s %s: expected %v, got %v", tc.platform, tc.path, tc.want, got)
+		}
+	}
+
+	_, ferr := callFunction(t, "validate_work_dir", tftypes.Bool,
+		tftypes.NewValue(tftypes.String, "mainframe"),
+		tftypes.NewValue(tftypes.String, "/work"))
+	if ferr == nil || ferr.FunctionArgument == nil || *ferr.FunctionArgument != 0 {
+		t.Errorf("expected an error on the platform argument, got %v", ferr)
+	}
+}
+
+func TestImportIDFunctions(t *testing.T) {
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	parsed, ferr := callFunction(t, "parse_import_id", mapType,
+		tftypes.NewValue(tftypes.String, "seqera_labels"),
+		tftypes.NewValue(tftypes.String, "7/team=genomics"))
+	if ferr != nil {
+		t.Fatal(ferr.Text)
+	}
+
+	formatted, ferr := callFunction(t, "format_import_id", tftypes.String,
+		tftypes.NewValue(tftypes.String, "seqera_labels"), parsed)
+	if ferr != nil {
+		t.Fatal(ferr.Text)
+	}
+	var id string
+	_ = formatted.As(&id)
+	if id != "7/team=genomics" {
+		t.Errorf("expected the import ID to round trip, got %q", id)
+	}
+
+	_, ferr = callFunction(t, "parse_import_id", mapType,
+		tftypes.NewValue(tftypes.String, "seqera_unknown"),
+		tftypes.NewValue(tftypes.String, "7"))
+	if ferr == nil || ferr.FunctionArgument == nil || *ferr.FunctionArgument != 0 {
+		t.Errorf("expected an error on the resource type argument, got %v", ferr)
+	}
+}
+
+func TestNextflowParamsFunction(t *testing.T) {
+	paramsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"outdir":  tftypes.String,
+		"input":   tftypes.String,
+		"max_cpu": tftypes.Number,
+		"skip_qc": tftypes.Bool,
+		"tools":   tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.String}},
+		"version": tftypes.String,
+	}}
+	params := tftypes.NewValue(paramsType, map[string]tftypes.Value{
+		"outdir":  tftypes.NewValue(tftypes.String, "s3://bucket/results"),
+		"input":   tftypes.NewValue(tftypes.String, "s3://bucket/samples.csv"),
+		"max_cpu": tftypes.NewValue(tftypes.Number, 16),
+		"skip_qc": tftypes.NewValue(tftypes.Bool, true),
+		"tools": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.String}}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "fastqc"),
+			tftypes.NewValue(tftypes.String, "multiqc"),
+		}),
+		"version": tftypes.NewValue(tftypes.String, "1.0"),
+	})
+
+	result, ferr := callFunction(t, "nextflow_params", tftypes.String, params)
+	if ferr != nil {
+		t.Fatal(ferr.Text)
+	}
+	var got string
+	_ = result.As(&got)
+	want := `input: s3://bucket/samples.csv
+max_cpu: 16
+outdir: s3://bucket/results
+skip_qc: true
+tools:
+  - fastqc
+  - multiqc
+version: "1.0"
+`
+	if got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	_, ferr = callFunction(t, "nextflow_params", tftypes.String,
+		tftypes.NewValue(tftypes.String, "input: x"))
+	if ferr == nil {
+		t.Error("expected an error for a string argument")
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &LabelsListResource{}
+	_ list.ListResourceWithConfigure = &LabelsListResource{}
+)
+
+func NewLabelsListResource() list.ListResource {
+	return &LabelsListResource{}
+}
+
+// LabelsListResource lists the labels of a workspace.
+type LabelsListResource struct {
+	client *sdk.Seqera
+}
+
+type LabelsListResourceModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (r *LabelsListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_labels"
+}
+
+func (r *LabelsListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the labels of a workspace, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_labels`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every label found:
+
+` + "```hcl" + `
+list "seqera_labels" "resource" {
+  provider = seqera
+
+  config {
+    type = "resource"
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": common.ListWorkspaceAttribute(),
+			"name":         common.ListNameAttribute(),
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list simple labels, or resource labels with a value. Default: "all"; must be one of ["simple", "resource", "all"]`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(shared.LabelTypeSimple),
+						string(shared.LabelTypeResource),
+						string(shared.LabelTypeAll),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *LabelsListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *LabelsListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data LabelsListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	workspaceID, workspaceDiags := common.ListWorkspace(r.client, data.WorkspaceID)
+	diags.Append(workspaceDiags...)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	labelType := shared.LabelTypeAll
+	if !data.Type.IsNull() {
+		labelType = shared.LabelType(data.Type.ValueString())
+	}
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		_, err := common.PaginatedSearch(ctx,
+			func(ctx context.Context, max, offset int) ([]shared.LabelDbDto, int64, error) {
+				res, err := r.client.Labels.ListLabels(ctx, operations.ListLabelsRequest{
+					WorkspaceID: &workspaceID,
+					Type:        labelType.ToPointer(),
+					Max:         &max,
+					Offset:      &offset,
+				})
+				if err != nil {
+					return nil, 0, fmt.Errorf("listing labels: %w", err)
+				}
+				if res.StatusCode != http.StatusOK || res.RawResponse == nil {
+					return nil, 0, common.UnexpectedStatusErr(ctx, "listing labels", res.RawResponse)
+				}
+				return common.DecodeLabelList(res.RawResponse.Body)
+			},
+			func(label *shared.LabelDbDto) bool {
+				if label.ID == nil || label.Name == nil || !matchName(*label.Name) {
+					return false
+				}
+				return !yield(common.ListItem{
+					DisplayName: labelDisplayName(label),
+					Attributes: map[string]attr.Value{
+						"workspace_id": types.Int64Value(workspaceID),
+						"label_id":     types.Int64Value(*label.ID),
+					},
+				})
+			},
+		)
+		return err
+	}
+
+	read, diags := newConfiguredResource(ctx, NewLabelsResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, labelIdentity, walk, read.Read)
+}
+
+// labelDisplayName shows a resource label as name=value, the way the
+// platform does.
+func labelDisplayName(label *shared.LabelDbDto) string {
+	if label.Value == nil || *label.Value == "" {
+		return *label.Name
+	}
+	return *label.Name + "=" + *label.Value
+}
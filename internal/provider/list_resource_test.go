@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+func TestProviderSchemaWithListResources(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(NewExtended("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	for name := range resp.ListResourceSchemas {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("list resource %s has no managed resource", name)
+		}
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name := range resp.ResourceSchemas {
+		if _, ok := identities.IdentitySchemas[name]; !ok {
+			t.Errorf("resource %s has no identity schema", name)
+		}
+	}
+}
+
+func TestPipelineListResource(t *testing.T) {
+	names := []string{"nf-core-rnaseq", "hello", "nf-core-sarek", "nf-core-atacseq"}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		max, _ := strconv.Atoi(r.URL.Query().Get("max"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		page := []map[string]any{}
+		for i := offset; i < len(names) && i < offset+max; i++ {
+			page = append(page, map[string]any{"pipelineId": i + 1, "name": names[i]})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"pipelines": page, "totalSize": len(names)})
+	}))
+	defer api.Close()
+
+	ctx := context.Background()
+	client := sdk.New(sdk.WithServerURL(api.URL))
+	lister := &PipelineListResource{client: client}
+	pipelines, diags := newConfiguredResource(ctx, NewPipelineResource, client)
+	if diags.HasError() {
+		t.Fatalf("configuring the pipeline resource: %v", diags)
+	}
+
+	var configSchema list.ListResourceSchemaResponse
+	lister.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	var resourceSchema resource.SchemaResponse
+	pipelines.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	pipelines.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	configType := configSchema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{
+		Schema: configSchema.Schema,
+		Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+			"workspace_id": tftypes.NewValue(tftypes.Number, 7),
+			"name":         tftypes.NewValue(tftypes.String, "nf-core-*"),
+		}),
+	}
+
+	for name, tc := range map[string]struct {
+		limit int64
+		want  []string
+	}{
+		"all":     {want: []string{"nf-core-rnaseq", "nf-core-sarek", "nf-core-atacseq"}},
+		"limited": {limit: 2, want: []string{"nf-core-rnaseq", "nf-core-sarek"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var stream list.ListResultsStream
+			lister.List(ctx, list.ListRequest{
+				Config:                 config,
+				Limit:                  tc.limit,
+				ResourceSchema:         resourceSchema.Schema,
+				ResourceIdentitySchema: identitySchema.IdentitySchema,
+			}, &stream)
+
+			var got []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+				}
+				var identity struct {
+					WorkspaceID int64 `tfsdk:"workspace_id"`
+					ID          int64 `tfsdk:"id"`
+				}
+				if diags := result.Identity.Get(ctx, &identity); diags.HasError() {
+					t.Fatalf("reading identity: %v", diags)
+				}
+				if identity.WorkspaceID != 7 || identity.ID == 0 {
+					t.Errorf("unexpected identity for %s: %+v", result.DisplayName, identity)
+				}
+				got = append(got, result.DisplayName)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("expected %v, got %v", tc.want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &PipelineListResource{}
+	_ list.ListResourceWithConfigure = &PipelineListResource{}
+)
+
+func NewPipelineListResource() list.ListResource {
+	return &PipelineListResource{}
+}
+
+// PipelineListResource lists the Launchpad pipelines of a workspace.
+type PipelineListResource struct {
+	client *sdk.Seqera
+}
+
+type PipelineListResourceModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (r *PipelineListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+func (r *PipelineListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the Launchpad pipelines of a workspace, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_pipeline`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every pipeline found:
+
+` + "```hcl" + `
+list "seqera_pipeline" "nf_core" {
+  provider = seqera
+
+  config {
+    name = "nf-core-*"
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": common.ListWorkspaceAttribute(),
+			"name":         common.ListNameAttribute(),
+		},
+	}
+}
+
+func (r *PipelineListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *PipelineListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data PipelineListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	workspaceID, workspaceDiags := common.ListWorkspace(r.client, data.WorkspaceID)
+	diags.Append(workspaceDiags...)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		_, err := common.PaginatedSearch(ctx,
+			func(ctx context.Context, max, offset int) ([]shared.PipelineDbDto, int64, error) {
+				res, err := r.client.Pipelines.ListPipelines(ctx, operations.ListPipelinesRequest{
+					WorkspaceID: &workspaceID,
+					Max:         &max,
+					Offset:      &offset,
+				})
+				if err != nil {
+					return nil, 0, fmt.Errorf("listing pipelines: %w", err)
+				}
+				if res.StatusCode != http.StatusOK || res.ListPipelinesResponse == nil {
+					return nil, 0, common.UnexpectedStatusErr(ctx, "listing pipelines", res.RawResponse)
+				}
+				var totalSize int64
+				if res.ListPipelinesResponse.TotalSize != nil {
+					totalSize = *res.ListPipelinesResponse.TotalSize
+				}
+				return res.ListPipelinesResponse.Pipelines, totalSize, nil
+			},
+			func(pipeline *shared.PipelineDbDto) bool {
+				if pipeline.PipelineID == nil || pipeline.Name == nil || !matchName(*pipeline.Name) {
+					return false
+				}
+				return !yield(common.ListItem{
+					DisplayName: *pipeline.Name,
+					Attributes: map[string]attr.Value{
+						"workspace_id": types.Int64Value(workspaceID),
+						"pipeline_id":  types.Int64Value(*pipeline.PipelineID),
+					},
+				})
+			},
+		)
+		return err
+	}
+
+	read, diags := newConfiguredResource(ctx, NewPipelineResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, pipelineIdentity, walk, read.Read)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &PipelineSecretListResource{}
+	_ list.ListResourceWithConfigure = &PipelineSecretListResource{}
+)
+
+func NewPipelineSecretListResource() list.ListResource {
+	return &PipelineSecretListResource{}
+}
+
+// PipelineSecretListResource lists the pipeline secrets of a workspace.
+type PipelineSecretListResource struct {
+	client *sdk.Seqera
+}
+
+type PipelineSecretListResourceModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (r *PipelineSecretListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_secret"
+}
+
+func (r *PipelineSecretListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the pipeline secrets of a workspace, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_pipeline_secret`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every secret found. Secret values are never returned by the platform, so the generated configuration needs them filled in:
+
+` + "```hcl" + `
+list "seqera_pipeline_secret" "all" {
+  provider = seqera
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": common.ListWorkspaceAttribute(),
+			"name":         common.ListNameAttribute(),
+		},
+	}
+}
+
+func (r *PipelineSecretListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *PipelineSecretListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data PipelineSecretListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	workspaceID, workspaceDiags := common.ListWorkspace(r.client, data.WorkspaceID)
+	diags.Append(workspaceDiags...)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		res, err := r.client.PipelineSecrets.ListPipelineSecrets(ctx, operations.ListPipelineSecretsRequest{
+			WorkspaceID: &workspaceID,
+		})
+		if err != nil {
+			return fmt.Errorf("listing pipeline secrets: %w", err)
+		}
+		if res.StatusCode != http.StatusOK || res.ListPipelineSecretsResponse == nil {
+			return common.UnexpectedStatusErr(ctx, "listing pipeline secrets", res.RawResponse)
+		}
+		for _, secret := range res.ListPipelineSecretsResponse.PipelineSecrets {
+			if secret.ID == nil || !matchName(secret.Name) {
+				continue
+			}
+			if !yield(common.ListItem{
+				DisplayName: secret.Name,
+				Attributes: map[string]attr.Value{
+					"workspace_id": types.Int64Value(workspaceID),
+					"secret_id":    types.Int64Value(*secret.ID),
+				},
+			}) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	read, diags := newConfiguredResource(ctx, NewPipelineSecretResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, pipelineSecretIdentity, walk, read.Read)
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	custom_stringvalidators "github.com/seqeralabs/terraform-provider-seqera/internal/validators/stringvalidators"
+)
+
+const (
+	// defaultCredentialHelperRefreshInterval applies when the helper prints a
+	// bare token without an expiry.
+	defaultCredentialHelperRefreshInterval = 10 * time.Minute
+
+	// credentialHelperExpiryMargin re-runs the helper this long before the
+	// reported expiry, so a token never expires between resolution and use.
+	credentialHelperExpiryMargin = time.Minute
+)
+
+// CredentialHelperModel describes the provider `credential_helper` block.
+type CredentialHelperModel struct {
+	Command         types.List   `tfsdk:"command"`
+	RefreshInterval types.String `tfsdk:"refresh_interval"`
+}
+
+// authAttributes are the provider attributes offering token sources besides
+// `bearer_auth`.
+func authAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"access_token_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path to a file holding the access token. The file is re-read before every API operation, so a token rotated on disk (for example by a Vault agent) is picked up during long applies. Configurable via environment variable `TOWER_ACCESS_TOKEN_FILE`.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("bearer_auth")),
+			},
+		},
+		"tw_config_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path to the `tw` CLI configuration file read by `tw_profile`. Configurable via environment variable `TOWER_CONFIG_FILE`. Default: `~/.tower/config.yml`.",
+		},
+		"tw_profile": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Name of a `tw` CLI profile to read the access token and API endpoint from. The endpoint is used when `server_url` is not set. Configurable via environment variable `TOWER_PROFILE`.",
+		},
+	}
+}
+
+func credentialHelperBlockSchema() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "External command that prints an access token, re-run whenever the previous token is about to expire. The command prints either the bare token, or a JSON object `{\"access_token\": \"...\", \"expires_at\": \"<RFC 3339 timestamp>\"}`.",
+		Attributes: map[string]schema.Attribute{
+			"command": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Program and arguments to run, without a shell, such as `[\"vault\", \"read\", \"-field=token\", \"secret/seqera\"]`. Required when the block is present.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"refresh_interval": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long to reuse a token printed without an expiry before running the command again. Default: `10m`.",
+				Validators: []validator.String{
+					custom_stringvalidators.DurationValidator(),
+				},
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(
+				path.MatchRoot("bearer_auth"),
+				path.MatchRoot("access_token_file"),
+			),
+		},
+	}
+}
+
+// tokenSource supplies the bearer token for API requests.
+type tokenSource interface {
+	token(ctx context.Context) (string, error)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// fileTokenSource reads the token from disk on every call.
+type fileTokenSource struct {
+	path string
+}
+
+func (s fileTokenSource) token(context.Context) (string, error) {
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("reading access token file: %w", err)
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("access token file %q is empty", s.path)
+	}
+	return token, nil
+}
+
+// commandTokenSource runs a credential helper and caches its token until it
+// is about to expire.
+type commandTokenSource struct {
+	command         []string
+	refreshInterval time.Duration
+
+	mu        sync.Mutex
+	cached    string
+	expiresAt time.Time
+}
+
+func (s *commandTokenSource) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != "" && time.Now().Before(s.expiresAt) {
+		return s.cached, nil
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...)
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("credential helper %q failed: %w: %s", s.command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	token, expiresAt, err := parseCredentialHelperOutput(out)
+	if err != nil {
+		return "", fmt.Errorf("credential helper %q: %w", s.command[0], err)
+	}
+
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(s.refreshInterval)
+	} else {
+		expiresAt = expiresAt.Add(-credentialHelperExpiryMargin)
+	}
+
+	s.cached = token
+	s.expiresAt = expiresAt
+	return token, nil
+}
+
+// parseCredentialHelperOutput accepts either a bare token or a JSON object
+// with `access_token` and an optional RFC 3339 `expires_at`.
+func parseCredentialHelperOutput(out []byte) (string, time.Time, error) {
+	trimmed := bytes.TrimSpace(out)
+	if len(trimmed) == 0 {
+		return "", time.Time{}, errors.New("printed no token")
+	}
+
+	if trimmed[0] != '{' {
+		return string(trimmed), time.Time{}, nil
+	}
+
+	var payload struct {
+		AccessToken string    `json:"access_token"`
+		ExpiresAt   time.Time `json:"expires_at"`
+	}
+	if err := json.Unmarshal(trimmed, &payload); err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid JSON output: %w", err)
+	}
+	if payload.AccessToken == "" {
+		return "", time.Time{}, errors.New("JSON output has no access_token")
+	}
+	return payload.AccessToken, payload.ExpiresAt, nil
+}
+
+// twProfile is one entry of the `tw` CLI configuration file:
+//
+//	profiles:
+//	  production:
+//	    access_token: eyJ0eXAi...
+//	    api_endpoint: https://seqera.my-company.io/api
+type twProfile struct {
+	AccessToken string `yaml:"access_token"`
+	APIEndpoint string `yaml:"api_endpoint"`
+}
+
+func loadTwProfile(configFile, name string) (twProfile, error) {
+	if configFile == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return twProfile{}, fmt.Errorf("locating the tw configuration file: %w", err)
+		}
+		configFile = filepath.Join(home, ".tower", "config.yml")
+	}
+
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		return twProfile{}, fmt.Errorf("reading the tw configuration file: %w", err)
+	}
+
+	var config struct {
+		Profiles map[string]twProfile `yaml:"profiles"`
+	}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return twProfile{}, fmt.Errorf("parsing %s: %w", configFile, err)
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return twProfile{}, fmt.Errorf("profile %q not found in %s", name, configFile)
+	}
+	return profile, nil
+}
+
+// authSettings is the resolved authentication: where the token comes from,
+// and the API endpoint of the `tw` profile, if one was used.
+type authSettings struct {
+	source          tokenSource
+	profileEndpoint string
+}
+
+// resolveAuthSettings picks the token source. Provider attributes win over
+// environment variables; within each group the order is the inline token,
+// the token file, the credential helper, then the `tw` profile. The source is
+// queried once so that an unreadable file or failing helper is reported at
+// Configure time rather than on the first API call.
+func resolveAuthSettings(ctx context.Context, data *ExtendedProviderModel) (authSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s authSettings
+
+	var profile twProfile
+	if profileName, _ := stringSetting(data.TwProfile, "TOWER_PROFILE"); profileName != "" {
+		configFile, _ := stringSetting(data.TwConfigFile, "TOWER_CONFIG_FILE")
+		loaded, err := loadTwProfile(configFile, profileName)
+		if err != nil {
+			diags.AddAttributeError(path.Root("tw_profile"), "Invalid Provider Authentication", err.Error())
+			return s, diags
+		}
+		profile = loaded
+		s.profileEndpoint = profile.APIEndpoint
+	}
+
+	switch {
+	case !data.BearerAuth.IsNull() && !data.BearerAuth.IsUnknown() && data.BearerAuth.ValueString() != "":
+		s.source = staticTokenSource(data.BearerAuth.ValueString())
+	case !data.AccessTokenFile.IsNull() && !data.AccessTokenFile.IsUnknown():
+		s.source = fileTokenSource{path: data.AccessTokenFile.ValueString()}
+	case data.CredentialHelper != nil:
+		helper, helperDiags := resolveCredentialHelper(data.CredentialHelper)
+		diags.Append(helperDiags...)
+		if helperDiags.HasError() {
+			return s, diags
+		}
+		s.source = helper
+	case !data.TwProfile.IsNull() && profile.AccessToken != "":
+		s.source = staticTokenSource(profile.AccessToken)
+	case os.Getenv("TOWER_ACCESS_TOKEN") != "":
+		s.source = staticTokenSource(os.Getenv("TOWER_ACCESS_TOKEN"))
+	case os.Getenv("TOWER_ACCESS_TOKEN_FILE") != "":
+		s.source = fileTokenSource{path: os.Getenv("TOWER_ACCESS_TOKEN_FILE")}
+	case profile.AccessToken != "":
+		s.source = staticTokenSource(profile.AccessToken)
+	default:
+		diags.AddError(
+			"Missing Provider Security Configuration",
+			"Either the environment variable TOWER_ACCESS_TOKEN or provider configuration bearer_auth attribute must be configured. "+
+				"Alternatively, set access_token_file, a credential_helper block or a tw_profile.",
+		)
+		return s, diags
+	}
+
+	if _, err := s.source.token(ctx); err != nil {
+		diags.AddError("Invalid Provider Authentication", err.Error())
+	}
+
+	return s, diags
+}
+
+func resolveCredentialHelper(data *CredentialHelperModel) (*commandTokenSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	helper := &commandTokenSource{refreshInterval: defaultCredentialHelperRefreshInterval}
+
+	if !data.Command.IsUnknown() {
+		for _, element := range data.Command.Elements() {
+			if arg, ok := element.(types.String); ok {
+				helper.command = append(helper.command, arg.ValueString())
+			}
+		}
+	}
+	if len(helper.command) == 0 {
+		diags.AddAttributeError(
+			path.Root("credential_helper").AtName("command"),
+			"Invalid Provider Authentication",
+			"credential_helper requires a command.",
+		)
+	}
+
+	if raw := data.RefreshInterval.ValueString(); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			diags.AddAttributeError(
+				path.Root("credential_helper").AtName("refresh_interval"),
+				"Invalid Provider Authentication",
+				fmt.Sprintf("refresh_interval must be a positive duration such as \"10m\", got %q", raw),
+			)
+		} else {
+			helper.refreshInterval = parsed
+		}
+	}
+
+	return helper, diags
+}
+
+// sdkOption returns the SDK security option. A static token is set once; any
+// other source is consulted before each API operation.
+func (s authSettings) sdkOption() sdk.SDKOption {
+	if static, ok := s.source.(staticTokenSource); ok {
+		return sdk.WithSecurity(shared.Security{BearerAuth: string(static)})
+	}
+	return sdk.WithSecuritySource(func(ctx context.Context) (shared.Security, error) {
+		token, err := s.source.token(ctx)
+		if err != nil {
+			return shared.Security{}, err
+		}
+		return shared.Security{BearerAuth: token}, nil
+	})
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseCredentialHelperOutput(t *testing.T) {
+	token, expiresAt, err := parseCredentialHelperOutput([]byte("  eyJ0eXAi\n"))
+	if err != nil || token != "eyJ0eXAi" || !expiresAt.IsZero() {
+		t.Errorf("bare token: got %q, %v, %v", token, expiresAt, err)
+	}
+
+	token, expiresAt, err = parseCredentialHelperOutput([]byte(`{"access_token":"abc","expires_at":"2030-01-02T03:04:05Z"}`))
+	if err != nil || token != "abc" || !expiresAt.Equal(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("JSON output: got %q, %v, %v", token, expiresAt, err)
+	}
+
+	if _, _, err := parseCredentialHelperOutput([]byte(`{"expires_at":"2030-01-02T03:04:05Z"}`)); err == nil {
+		t.Error("expected an error for JSON output without access_token")
+	}
+}
+
+func TestCommandTokenSourceCachesUntilExpiry(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "calls")
+	source := &commandTokenSource{
+		command:         []string{"sh", "-c", `echo x >> "$0"; wc -l < "$0"`, counter},
+		refreshInterval: time.Hour,
+	}
+
+	for range 3 {
+		token, err := source.token(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "1" {
+			t.Fatalf("expected the cached first token, got %q", token)
+		}
+	}
+
+	source.expiresAt = time.Now().Add(-time.Second)
+	if token, _ := source.token(context.Background()); token != "2" {
+		t.Errorf("expected the helper to run again after expiry, got %q", token)
+	}
+}
+
+func TestResolveAuthSettingsPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.yml")
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(configFile, []byte("profiles:\n  prod:\n    access_token: from-profile\n    api_endpoint: https://seqera.example.com/api\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(tokenFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("TOWER_ACCESS_TOKEN", "from-env")
+	t.Setenv("TOWER_ACCESS_TOKEN_FILE", "")
+	t.Setenv("TOWER_PROFILE", "")
+
+	for name, tc := range map[string]struct {
+		data ExtendedProviderModel
+		want string
+	}{
+		"token file attribute wins over env": {
+			data: ExtendedProviderModel{AccessTokenFile: types.StringValue(tokenFile)},
+			want: "from-file",
+		},
+		"profile attribute wins over env": {
+			data: ExtendedProviderModel{TwProfile: types.StringValue("prod"), TwConfigFile: types.StringValue(configFile)},
+			want: "from-profile",
+		},
+		"env without attributes": {
+			want: "from-env",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s, diags := resolveAuthSettings(context.Background(), &tc.data)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got, _ := s.source.token(context.Background()); got != tc.want {
+				t.Errorf("expected token %q, got %q", tc.want, got)
+			}
+		})
+	}
+
+	s, _ := resolveAuthSettings(context.Background(), &ExtendedProviderModel{
+		TwProfile:    types.StringValue("prod"),
+		TwConfigFile: types.StringValue(configFile),
+	})
+	if s.profileEndpoint != "https://seqera.example.com/api" {
+		t.Errorf("expected the profile endpoint, got %q", s.profileEndpoint)
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+	custom_stringvalidators "github.com/seqeralabs/terraform-provider-seqera/internal/validators/stringvalidators"
+)
+
+// defaultsAttributes are the provider attributes supplying values that
+// resources fall back to when their own configuration omits them.
+func defaultsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"adopt_on_conflict": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "When a compute environment, credential, pipeline, pipeline secret, label, team or workspace cannot be created because an object of the same name already exists (HTTP 409), take the existing object into state and update it to match the configuration instead of failing. Lets an apply interrupted after the object was created complete on the next run. Attributes the API cannot change in place show up as changes on the next plan. Default: false.",
+		},
+		"default_labels": schema.MapAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Labels attached to every `seqera_action`, `seqera_datasets`, `seqera_pipeline` and `seqera_workflows` the provider creates or updates, as label values keyed by label name. An empty value denotes a simple label, any other value a resource label. Missing labels are created in the resource's workspace. The defaults are added to the labels set by the resource itself, and are not shown in its `label_ids`. Set `ignore_default_labels` on a resource to opt out.",
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(custom_stringvalidators.LabelNameValidator()),
+			},
+		},
+		"default_workspace": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Workspace used by resources that omit `workspace_id`, as a numeric ID or an `org/workspace` full name. Resolved once when the provider is configured; plans show the resolved ID. Configurable via environment variable `TOWER_WORKSPACE_ID`.",
+		},
+	}
+}
+
+// resolveProviderDefaults resolves the provider-level defaults against the
+// API, for common.SetProviderDefaults.
+func resolveProviderDefaults(ctx context.Context, client *sdk.Seqera, data *ExtendedProviderModel) (common.ProviderDefaults, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var defaults common.ProviderDefaults
+
+	if data.DefaultWorkspace.IsUnknown() {
+		defaults.WorkspaceUnknown = true
+	} else if ref := data.DefaultWorkspace.ValueString(); ref != "" || os.Getenv("TOWER_WORKSPACE_ID") != "" {
+		source := "default_workspace"
+		if ref == "" {
+			ref, source = os.Getenv("TOWER_WORKSPACE_ID"), "TOWER_WORKSPACE_ID"
+		}
+		workspaceID, err := common.ResolveWorkspace(ctx, client, ref)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("default_workspace"),
+				"Invalid Default Workspace",
+				"Unable to resolve "+source+": "+err.Error(),
+			)
+		}
+		defaults.WorkspaceID = workspaceID
+	}
+
+	if !data.DefaultLabels.IsNull() && !data.DefaultLabels.IsUnknown() {
+		defaults.Labels = map[string]string{}
+		diags.Append(data.DefaultLabels.ElementsAs(ctx, &defaults.Labels, false)...)
+	}
+
+	defaults.AdoptOnConflict = data.AdoptOnConflict.ValueBool()
+
+	return defaults, diags
+}
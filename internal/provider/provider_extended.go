@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/access_token"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/agent"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/agents_data"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/cancel_workflow"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/encrypted_credentials"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/extend_studio"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/format_import_id"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/launch_action"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/launch_agent"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/launch_pipeline"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/managed_identity"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/managed_identity_credential"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/managed_identity_data"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/nextflow_params"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/organization_idp_group"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/organization_idp_groups_data"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/organization_scim"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/parse_import_id"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/scim_token"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/ssh_key"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/ssh_keys_data"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/start_studio"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/stop_studio"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/validate_work_dir"
+)
+
+// ExtendedProvider is the provider served by main.go. It embeds the
+// Speakeasy-generated SeqeraProvider (provider.go), which stays regenerable,
+// and adds what the generator cannot express: the provider attributes for
+// authentication sources, defaults, TLS, retries and rate limits
+// (provider_*.go), the functions, actions, ephemeral and list resources, and
+// the hand-written behaviour of the generated resources
+// (resource_extensions.go).
+type ExtendedProvider struct {
+	*SeqeraProvider
+}
+
+var (
+	_ provider.Provider                       = (*ExtendedProvider)(nil)
+	_ provider.ProviderWithActions            = (*ExtendedProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*ExtendedProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*ExtendedProvider)(nil)
+	_ provider.ProviderWithListResources      = (*ExtendedProvider)(nil)
+)
+
+// ExtendedProviderModel describes the provider data model, the generated
+// bearer_auth and server_url included.
+type ExtendedProviderModel struct {
+	AccessTokenFile       types.String           `tfsdk:"access_token_file"`
+	AdoptOnConflict       types.Bool             `tfsdk:"adopt_on_conflict"`
+	BearerAuth            types.String           `tfsdk:"bearer_auth"`
+	CABundle              types.String           `tfsdk:"ca_bundle"`
+	CABundleFile          types.String           `tfsdk:"ca_bundle_file"`
+	ClientCertificate     types.String           `tfsdk:"client_certificate"`
+	ClientCertificateFile types.String           `tfsdk:"client_certificate_file"`
+	ClientKey             types.String           `tfsdk:"client_key"`
+	ClientKeyFile         types.String           `tfsdk:"client_key_file"`
+	CredentialHelper      *CredentialHelperModel `tfsdk:"credential_helper"`
+	DefaultLabels         types.Map              `tfsdk:"default_labels"`
+	DefaultWorkspace      types.String           `tfsdk:"default_workspace"`
+	HTTPSProxy            types.String           `tfsdk:"https_proxy"`
+	InsecureSkipVerify    types.Bool             `tfsdk:"insecure_skip_verify"`
+	MaxConcurrentRequests types.Int64            `tfsdk:"max_concurrent_requests"`
+	RequestTimeout        types.String           `tfsdk:"request_timeout"`
+	RequestsPerSecond     types.Float64          `tfsdk:"requests_per_second"`
+	Retry                 *RetryModel            `tfsdk:"retry"`
+	ServerURL             types.String           `tfsdk:"server_url"`
+	TwConfigFile          types.String           `tfsdk:"tw_config_file"`
+	TwProfile             types.String           `tfsdk:"tw_profile"`
+}
+
+func (p *ExtendedProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	p.SeqeraProvider.Schema(ctx, req, resp)
+
+	maps.Copy(resp.Schema.Attributes, authAttributes())
+	maps.Copy(resp.Schema.Attributes, defaultsAttributes())
+	maps.Copy(resp.Schema.Attributes, httpClientAttributes())
+	maps.Copy(resp.Schema.Attributes, rateLimitAttributes())
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"credential_helper": credentialHelperBlockSchema(),
+		"retry":             retryBlockSchema(),
+	}
+}
+
+// Configure replaces the generated Configure, which only knows bearer_auth
+// and TOWER_ACCESS_TOKEN.
+func (p *ExtendedProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data ExtendedProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authSettings, authDiags := resolveAuthSettings(ctx, &data)
+	resp.Diagnostics.Append(authDiags...)
+
+	serverUrl := data.ServerURL.ValueString()
+
+	if serverUrl == "" {
+		serverUrl = authSettings.profileEndpoint
+	}
+
+	if serverUrl == "" {
+		serverUrl = "https://api.cloud.seqera.io"
+	}
+
+	retrySettings, retryDiags := resolveRetrySettings(ctx, data.Retry)
+	resp.Diagnostics.Append(retryDiags...)
+
+	httpClientSettings, httpClientDiags := resolveHTTPClientSettings(&data)
+	resp.Diagnostics.Append(httpClientDiags...)
+
+	limiter, limiterDiags := resolveRequestLimiter(&data)
+	resp.Diagnostics.Append(limiterDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transport, err := httpClientSettings.transport()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid TLS Configuration", err.Error())
+		return
+	}
+
+	httpClient := &http.Client{
+		Transport: retrySettings.wrapTransport(limiter.wrapTransport(newRedactingLogTransport(transport))),
+	}
+
+	opts := []sdk.SDKOption{
+		sdk.WithServerURL(serverUrl),
+		authSettings.sdkOption(),
+		sdk.WithClient(httpClient),
+		sdk.WithRetryConfig(retrySettings.sdkConfig()),
+	}
+
+	if httpClientSettings.requestTimeout > 0 {
+		opts = append(opts, sdk.WithTimeout(httpClientSettings.requestTimeout))
+	}
+
+	client := sdk.New(opts...)
+
+	detectServerInfo(ctx, client)
+
+	defaults, defaultsDiags := resolveProviderDefaults(ctx, client, &data)
+	resp.Diagnostics.Append(defaultsDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	common.SetProviderDefaults(client, defaults)
+
+	resp.ActionData = client
+	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
+	resp.ResourceData = client
+}
+
+func (p *ExtendedProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		parse_import_id.NewFunction,
+		format_import_id.NewFunction,
+		validate_work_dir.NewFunction,
+		nextflow_params.NewFunction,
+	}
+}
+
+func (p *ExtendedProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		launch_pipeline.NewAction,
+		cancel_workflow.NewAction,
+		launch_action.NewAction,
+		start_studio.NewAction,
+		stop_studio.NewAction,
+		extend_studio.NewAction,
+		launch_agent.NewAction,
+	}
+}
+
+// Resources registers the generated resources wrapped with their extension
+// (resource_extensions.go); the hand-written ones, from gen.yaml or listed
+// here, are registered as they are.
+func (p *ExtendedProvider) Resources(ctx context.Context) []func() resource.Resource {
+	resources := p.SeqeraProvider.Resources(ctx)
+	for i, newResource := range resources {
+		resources[i] = func() resource.Resource {
+			return extendResource(newResource())
+		}
+	}
+	return append(resources,
+		ssh_key.NewResource,
+		agent.NewResource,
+		managed_identity.NewResource,
+		managed_identity_credential.NewResource,
+		organization_idp_group.NewResource,
+		organization_scim.NewResource,
+	)
+}
+
+func (p *ExtendedProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	dataSources := p.SeqeraProvider.DataSources(ctx)
+	for i, newDataSource := range dataSources {
+		dataSources[i] = func() datasource.DataSource {
+			return extendDataSource(newDataSource())
+		}
+	}
+	return append(dataSources,
+		ssh_keys_data.NewDataSource,
+		agents_data.NewDataSource,
+		managed_identity_data.NewDataSource,
+		organization_idp_groups_data.NewDataSource,
+	)
+}
+
+func (p *ExtendedProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		access_token.NewEphemeralResource,
+		scim_token.NewEphemeralResource,
+		encrypted_credentials.NewEphemeralResource,
+	}
+}
+
+func (p *ExtendedProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewActionListResource,
+		NewComputeEnvListResource,
+		NewCredentialListResource,
+		NewDataLinkListResource,
+		NewLabelsListResource,
+		NewPipelineListResource,
+		NewPipelineSecretListResource,
+		NewStudiosListResource,
+		NewTeamsListResource,
+		NewWorkspaceListResource,
+	}
+}
+
+// NewExtended returns the factory of the provider served by main.go.
+func NewExtended(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &ExtendedProvider{SeqeraProvider: New(version)().(*SeqeraProvider)}
+	}
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	custom_stringvalidators "github.com/seqeralabs/terraform-provider-seqera/internal/validators/stringvalidators"
+)
+
+// httpClientAttributes are the provider attributes controlling how the
+// provider connects to the API: TLS trust, mTLS, proxy and request timeout.
+func httpClientAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"ca_bundle": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "PEM-encoded CA certificates to trust in addition to the system roots, for Enterprise deployments served with a private CA. Conflicts with `ca_bundle_file`.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("ca_bundle_file")),
+			},
+		},
+		"ca_bundle_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path to a PEM file of CA certificates to trust in addition to the system roots. Configurable via environment variable `SEQERA_CA_BUNDLE`.",
+		},
+		"client_certificate": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "PEM-encoded client certificate presented for mutual TLS. Requires `client_key` or `client_key_file`. Conflicts with `client_certificate_file`.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("client_certificate_file")),
+			},
+		},
+		"client_certificate_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path to a PEM client certificate presented for mutual TLS. Configurable via environment variable `SEQERA_CLIENT_CERTIFICATE_FILE`.",
+		},
+		"client_key": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			MarkdownDescription: "PEM-encoded private key of the mutual TLS client certificate. Conflicts with `client_key_file`.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("client_key_file")),
+			},
+		},
+		"client_key_file": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path to the PEM private key of the mutual TLS client certificate. Configurable via environment variable `SEQERA_CLIENT_KEY_FILE`.",
+		},
+		"https_proxy": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "URL of the proxy to send API requests through, such as `http://proxy.internal:3128`. When unset, the standard `HTTPS_PROXY` and `NO_PROXY` environment variables apply.",
+		},
+		"insecure_skip_verify": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Skip verification of the server's TLS certificate. Only meant for lab setups with self-signed certificates; prefer `ca_bundle` everywhere else. Configurable via environment variable `SEQERA_INSECURE_SKIP_VERIFY`. Default: `false`.",
+		},
+		"request_timeout": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Maximum duration of a single API operation, retries included, as a duration string such as `\"2m\"`. Compute environment status polling is bounded by the resource `timeouts` block instead. Configurable via environment variable `SEQERA_REQUEST_TIMEOUT`. Default: no timeout.",
+			Validators: []validator.String{
+				custom_stringvalidators.DurationValidator(),
+			},
+		},
+	}
+}
+
+// httpClientSettings is the resolved connection configuration: provider
+// attributes, then environment variables, then defaults.
+type httpClientSettings struct {
+	caBundle           []byte
+	clientCertificate  []byte
+	clientKey          []byte
+	proxyURL           *url.URL
+	insecureSkipVerify bool
+	requestTimeout     time.Duration
+}
+
+func resolveHTTPClientSettings(data *ExtendedProviderModel) (httpClientSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s httpClientSettings
+
+	s.caBundle = pemSetting(&diags, "ca_bundle", data.CABundle, data.CABundleFile, "SEQERA_CA_BUNDLE")
+	s.clientCertificate = pemSetting(&diags, "client_certificate", data.ClientCertificate, data.ClientCertificateFile, "SEQERA_CLIENT_CERTIFICATE_FILE")
+	s.clientKey = pemSetting(&diags, "client_key", data.ClientKey, data.ClientKeyFile, "SEQERA_CLIENT_KEY_FILE")
+
+	if (s.clientCertificate == nil) != (s.clientKey == nil) {
+		diags.AddAttributeError(
+			path.Root("client_certificate"),
+			"Invalid TLS Configuration",
+			"A client certificate and a client key must be configured together for mutual TLS.",
+		)
+	}
+
+	if raw := data.HTTPSProxy.ValueString(); raw != "" {
+		proxyURL, err := url.Parse(raw)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			diags.AddAttributeError(
+				path.Root("https_proxy"),
+				"Invalid Proxy Configuration",
+				fmt.Sprintf("https_proxy must be an absolute URL such as \"http://proxy.internal:3128\", got %q", raw),
+			)
+		} else {
+			s.proxyURL = proxyURL
+		}
+	}
+
+	if !data.InsecureSkipVerify.IsNull() && !data.InsecureSkipVerify.IsUnknown() {
+		s.insecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	} else if raw := os.Getenv("SEQERA_INSECURE_SKIP_VERIFY"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid TLS Configuration",
+				fmt.Sprintf("SEQERA_INSECURE_SKIP_VERIFY must be true or false, got %q", raw),
+			)
+		} else {
+			s.insecureSkipVerify = parsed
+		}
+	}
+
+	if raw, source := stringSetting(data.RequestTimeout, "SEQERA_REQUEST_TIMEOUT"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			diags.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("%s must be a non-negative duration such as \"2m\", got %q", source, raw),
+			)
+		} else {
+			s.requestTimeout = parsed
+		}
+	}
+
+	if s.insecureSkipVerify {
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Verification Disabled",
+			"The provider does not verify the Seqera Platform TLS certificate. Use ca_bundle to trust a private CA instead.",
+		)
+	}
+
+	return s, diags
+}
+
+// pemSetting returns PEM content from the inline attribute, or read from the
+// file named by the `<attr>_file` attribute or fileEnvVar. It returns nil
+// when none of them is set.
+func pemSetting(diags *diag.Diagnostics, attr string, inline, file types.String, fileEnvVar string) []byte {
+	if !inline.IsNull() && !inline.IsUnknown() {
+		return []byte(inline.ValueString())
+	}
+
+	name, source := stringSetting(file, fileEnvVar)
+	if name == "" {
+		return nil
+	}
+	if source == "value" {
+		source = attr + "_file"
+	}
+
+	content, err := os.ReadFile(name)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attr+"_file"),
+			"Invalid TLS Configuration",
+			fmt.Sprintf("Unable to read %s %q: %s", source, name, err),
+		)
+		return nil
+	}
+	return content
+}
+
+// transport builds the base HTTP transport. It starts from a clone of
+// http.DefaultTransport, so the connection pool and proxy defaults match the
+// standard library without modifying the process-wide instance.
+func (s httpClientSettings) transport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: s.insecureSkipVerify, //nolint:gosec // opt-in, warned about at Configure time
+	}
+
+	if s.caBundle != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(s.caBundle) {
+			return nil, fmt.Errorf("no PEM certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if s.clientCertificate != nil && s.clientKey != nil {
+		certificate, err := tls.X509KeyPair(s.clientCertificate, s.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if s.proxyURL != nil {
+		transport.Proxy = http.ProxyURL(s.proxyURL)
+	}
+
+	return transport, nil
+}
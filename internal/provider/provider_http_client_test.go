@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHTTPClientSettingsTrustCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	for name, bundle := range map[string]types.String{
+		"without ca_bundle": types.StringNull(),
+		"with ca_bundle":    types.StringValue(string(caBundle)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			s, diags := resolveHTTPClientSettings(&ExtendedProviderModel{CABundle: bundle})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			transport, err := s.transport()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if bundle.IsNull() {
+				if err == nil {
+					res.Body.Close()
+					t.Fatal("expected a certificate verification error without the CA bundle")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected the CA bundle to be trusted: %v", err)
+			}
+			res.Body.Close()
+		})
+	}
+}
+
+func TestHTTPClientSettingsValidation(t *testing.T) {
+	t.Setenv("SEQERA_REQUEST_TIMEOUT", "soon")
+
+	_, diags := resolveHTTPClientSettings(&ExtendedProviderModel{
+		ClientCertificate: types.StringValue("-----BEGIN CERTIFICATE-----"),
+		HTTPSProxy:        types.StringValue("proxy.internal:3128"),
+	})
+
+	if got := diags.ErrorsCount(); got != 3 {
+		t.Errorf("expected errors for the missing client key, the relative proxy URL and the timeout, got %d: %v", got, diags)
+	}
+}
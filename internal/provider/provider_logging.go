@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// redactingLogTransport logs every request and response at debug level like
+// the generated providerHttpTransport (utils.go), reusing its decomposition
+// into log fields, but masks credentials in headers and secrets in bodies
+// first: TF_LOG=DEBUG output is routinely attached to support tickets.
+type redactingLogTransport struct {
+	transport http.RoundTripper
+}
+
+func newRedactingLogTransport(transport http.RoundTripper) http.RoundTripper {
+	return &redactingLogTransport{transport: transport}
+}
+
+func (t *redactingLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transactionID, err := uuid.GenerateUUID()
+	if err != nil {
+		transactionID = "Unable to assign Transaction ID: " + err.Error()
+	}
+	ctx := tflog.SetField(req.Context(), FieldHttpTransactionId, transactionID)
+
+	fields, err := decomposeRequestForLogging(req)
+	if err != nil {
+		tflog.Error(ctx, "Failed to parse request bytes for logging", map[string]interface{}{"error": err})
+	} else {
+		redactLogFields(fields, FieldHttpRequestBody, req.URL.Path)
+		tflog.Debug(ctx, "Sending HTTP Request", fields)
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	fields, err = decomposeResponseForLogging(res)
+	if err != nil {
+		tflog.Error(ctx, "Failed to parse response bytes for logging", map[string]interface{}{"error": err})
+	} else {
+		redactLogFields(fields, FieldHttpResponseBody, req.URL.Path)
+		tflog.Debug(ctx, "Received HTTP Response", fields)
+	}
+
+	return res, nil
+}
+
+// redactLogFields masks the sensitive headers among fields, and the secrets
+// in the body logged under bodyField for the API endpoint at urlPath.
+func redactLogFields(fields map[string]interface{}, bodyField, urlPath string) {
+	for name := range fields {
+		if common.IsSensitiveHeader(name) {
+			fields[name] = common.Redacted
+		}
+	}
+	if body, ok := fields[bodyField].(string); ok {
+		fields[bodyField] = string(common.RedactBody(urlPath, []byte(body)))
+	}
+}
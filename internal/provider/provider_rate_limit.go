@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/retry"
+)
+
+// rateLimitAttributes are the provider attributes throttling API requests on
+// the client side.
+func rateLimitAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"max_concurrent_requests": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Maximum number of API requests in flight at once, across all resources and data sources of this provider. Configurable via environment variable `SEQERA_MAX_CONCURRENT_REQUESTS`. Default: no limit.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"requests_per_second": schema.Float64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Maximum rate of API requests, retries included, across all resources and data sources of this provider. Fractions are allowed, such as `0.5` for one request every two seconds. Configurable via environment variable `SEQERA_REQUESTS_PER_SECOND`. Default: no limit.",
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0.01),
+			},
+		},
+	}
+}
+
+// resolveRequestLimiter builds the limiter shared by every request of the
+// provider. Throttling responses carrying `Retry-After` pause all requests
+// even when neither limit is set.
+func resolveRequestLimiter(data *ExtendedProviderModel) (*requestLimiter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	l := &requestLimiter{}
+
+	maxConcurrent := data.MaxConcurrentRequests.ValueInt64()
+	if data.MaxConcurrentRequests.IsNull() || data.MaxConcurrentRequests.IsUnknown() {
+		if raw := os.Getenv("SEQERA_MAX_CONCURRENT_REQUESTS"); raw != "" {
+			parsed, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || parsed < 1 {
+				diags.AddAttributeError(
+					path.Root("max_concurrent_requests"),
+					"Invalid Rate Limit Configuration",
+					fmt.Sprintf("SEQERA_MAX_CONCURRENT_REQUESTS must be a positive integer, got %q", raw),
+				)
+			} else {
+				maxConcurrent = parsed
+			}
+		}
+	}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	perSecond := data.RequestsPerSecond.ValueFloat64()
+	if data.RequestsPerSecond.IsNull() || data.RequestsPerSecond.IsUnknown() {
+		if raw := os.Getenv("SEQERA_REQUESTS_PER_SECOND"); raw != "" {
+			parsed, err := strconv.ParseFloat(raw, 64)
+			if err != nil || parsed <= 0 || math.IsInf(parsed, 0) {
+				diags.AddAttributeError(
+					path.Root("requests_per_second"),
+					"Invalid Rate Limit Configuration",
+					fmt.Sprintf("SEQERA_REQUESTS_PER_SECOND must be a positive number, got %q", raw),
+				)
+			} else {
+				perSecond = parsed
+			}
+		}
+	}
+	if perSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+
+	return l, diags
+}
+
+// requestLimiter caps the number of requests in flight and spaces their
+// start times, and pauses every request while the API asks clients to back
+// off through `Retry-After`.
+type requestLimiter struct {
+	// slots holds one token per request in flight; nil means no cap.
+	slots chan struct{}
+
+	// interval is the minimum spacing between request starts; zero means no
+	// rate limit.
+	interval time.Duration
+
+	mu sync.Mutex
+	// next is the earliest start of the next request under the rate limit.
+	next time.Time
+	// pausedUntil is when the last `Retry-After` elapses.
+	pausedUntil time.Time
+}
+
+// wrapTransport throttles the requests sent through transport.
+func (l *requestLimiter) wrapTransport(transport http.RoundTripper) http.RoundTripper {
+	return &limitedTransport{transport: transport, limiter: l}
+}
+
+// limitedTransport waits for the shared concurrency and rate limits before
+// sending each request, and pauses all requests on a throttling response.
+type limitedTransport struct {
+	transport http.RoundTripper
+	limiter   *requestLimiter
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	release, err := t.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+	t.limiter.observe(ctx, res)
+	return res, nil
+}
+
+// acquire blocks until a request may be sent. The returned function releases
+// the concurrency slot and must be called once the response is received.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	l.mu.Lock()
+	start := time.Now()
+	if l.next.After(start) {
+		start = l.next
+	}
+	if l.pausedUntil.After(start) {
+		start = l.pausedUntil
+	}
+	if l.interval > 0 {
+		l.next = start.Add(l.interval)
+	}
+	l.mu.Unlock()
+
+	// A Retry-After received while waiting extends the wait.
+	for {
+		if err := sleepUntil(ctx, start); err != nil {
+			release()
+			return nil, err
+		}
+		l.mu.Lock()
+		pausedUntil := l.pausedUntil
+		l.mu.Unlock()
+		if !pausedUntil.After(time.Now()) {
+			return release, nil
+		}
+		start = pausedUntil
+	}
+}
+
+// observe pauses all requests for the `Retry-After` of a throttling response.
+func (l *requestLimiter) observe(ctx context.Context, res *http.Response) {
+	if res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusServiceUnavailable {
+		return
+	}
+	var wait time.Duration
+	if temporary, ok := retry.TemporaryFromResponse("", res).(*retry.TemporaryError); ok {
+		wait = temporary.RetryAfter()
+	}
+	if wait <= 0 {
+		return
+	}
+
+	until := time.Now().Add(wait)
+	l.mu.Lock()
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+	l.mu.Unlock()
+
+	tflog.Debug(ctx, "API asked to back off; pausing all requests", map[string]interface{}{
+		"status_code": res.StatusCode,
+		"retry_after": wait.String(),
+	})
+}
+
+func sleepUntil(ctx context.Context, t time.Time) error {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
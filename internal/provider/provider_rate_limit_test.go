@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRequestLimiterCapsConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+	}))
+	defer server.Close()
+
+	limiter, diags := resolveRequestLimiter(&ExtendedProviderModel{
+		MaxConcurrentRequests: types.Int64Value(2),
+		RequestsPerSecond:     types.Float64Null(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	client := &http.Client{Transport: limiter.wrapTransport(http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if res, err := client.Get(server.URL); err == nil {
+				res.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", got)
+	}
+}
+
+func TestRequestLimiterSpacesRequests(t *testing.T) {
+	limiter := &requestLimiter{interval: 20 * time.Millisecond}
+
+	start := time.Now()
+	for range 4 {
+		release, err := limiter.acquire(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("expected 4 requests at 50/s to take at least 60ms, took %s", elapsed)
+	}
+}
+
+func TestRequestLimiterSharesRetryAfter(t *testing.T) {
+	limiter := &requestLimiter{}
+	limiter.observe(context.Background(), &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"1"}},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); err == nil {
+		t.Error("expected other requests to wait out the Retry-After")
+	}
+}
+
+func TestResolveRequestLimiterInvalidEnv(t *testing.T) {
+	t.Setenv("SEQERA_REQUESTS_PER_SECOND", "fast")
+
+	_, diags := resolveRequestLimiter(&ExtendedProviderModel{
+		MaxConcurrentRequests: types.Int64Null(),
+		RequestsPerSecond:     types.Float64Null(),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error for a non-numeric SEQERA_REQUESTS_PER_SECOND")
+	}
+}
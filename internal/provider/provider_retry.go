@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/retry"
+	custom_stringvalidators "github.com/seqeralabs/terraform-provider-seqera/internal/validators/stringvalidators"
+)
+
+// Defaults mirror overlays/retries.yaml so that omitting the `retry` block
+// keeps the policy the SDK was generated with.
+const (
+	defaultRetryInitialInterval = 500 * time.Millisecond
+	defaultRetryMaxInterval     = 30 * time.Second
+	defaultRetryMaxElapsedTime  = 5 * time.Minute
+	defaultRetryExponent        = 1.5
+)
+
+// sdkRetryStatusCodes are hard-wired into every generated SDK operation by
+// overlays/retries.yaml. They are always retried; `status_codes` can only add
+// to this set.
+var sdkRetryStatusCodes = []int{429, 502, 503, 504}
+
+// RetryModel describes the provider `retry` block.
+type RetryModel struct {
+	InitialInterval       types.String  `tfsdk:"initial_interval"`
+	MaxInterval           types.String  `tfsdk:"max_interval"`
+	MaxElapsedTime        types.String  `tfsdk:"max_elapsed_time"`
+	Exponent              types.Float64 `tfsdk:"exponent"`
+	StatusCodes           types.List    `tfsdk:"status_codes"`
+	RetryConnectionErrors types.Bool    `tfsdk:"retry_connection_errors"`
+}
+
+func retryBlockSchema() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Retry policy applied to every API request. Attributes left unset fall back to the matching `SEQERA_RETRY_*` environment variable, then to the built-in default.",
+		Attributes: map[string]schema.Attribute{
+			"initial_interval": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Wait before the first retry, as a duration string. Configurable via environment variable `SEQERA_RETRY_INITIAL_INTERVAL`. Default: `500ms`.",
+				Validators: []validator.String{
+					custom_stringvalidators.DurationValidator(),
+				},
+			},
+			"max_interval": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Upper bound for the wait between two attempts. Configurable via environment variable `SEQERA_RETRY_MAX_INTERVAL`. Default: `30s`.",
+				Validators: []validator.String{
+					custom_stringvalidators.DurationValidator(),
+				},
+			},
+			"max_elapsed_time": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Total time after which a request stops being retried. `0s` disables retries. Configurable via environment variable `SEQERA_RETRY_MAX_ELAPSED_TIME`. Default: `5m`.",
+				Validators: []validator.String{
+					custom_stringvalidators.DurationValidator(),
+				},
+			},
+			"exponent": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Exponent of the backoff curve. Configurable via environment variable `SEQERA_RETRY_EXPONENT`. Default: `1.5`.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(1),
+				},
+			},
+			"status_codes": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				MarkdownDescription: "HTTP status codes to retry in addition to `429`, `502`, `503` and `504`, which are always retried. Useful for proxies that answer transient failures with `500`. Configurable via environment variable `SEQERA_RETRY_STATUS_CODES` as a comma-separated list.",
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
+			"retry_connection_errors": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to retry requests that fail before a response is received (connection refused, DNS failure, timeouts). Configurable via environment variable `SEQERA_RETRY_CONNECTION_ERRORS`. Default: `true`.",
+			},
+		},
+	}
+}
+
+// retrySettings is the resolved retry policy: block attributes, then
+// environment variables, then defaults.
+type retrySettings struct {
+	initialInterval       time.Duration
+	maxInterval           time.Duration
+	maxElapsedTime        time.Duration
+	exponent              float64
+	statusCodes           []int
+	retryConnectionErrors bool
+}
+
+func resolveRetrySettings(ctx context.Context, data *RetryModel) (retrySettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data == nil {
+		data = &RetryModel{
+			InitialInterval:       types.StringNull(),
+			MaxInterval:           types.StringNull(),
+			MaxElapsedTime:        types.StringNull(),
+			Exponent:              types.Float64Null(),
+			StatusCodes:           types.ListNull(types.Int64Type),
+			RetryConnectionErrors: types.BoolNull(),
+		}
+	}
+
+	s := retrySettings{
+		initialInterval:       defaultRetryInitialInterval,
+		maxInterval:           defaultRetryMaxInterval,
+		maxElapsedTime:        defaultRetryMaxElapsedTime,
+		exponent:              defaultRetryExponent,
+		retryConnectionErrors: true,
+	}
+
+	durations := []struct {
+		attr   string
+		env    string
+		value  types.String
+		target *time.Duration
+	}{
+		{"initial_interval", "SEQERA_RETRY_INITIAL_INTERVAL", data.InitialInterval, &s.initialInterval},
+		{"max_interval", "SEQERA_RETRY_MAX_INTERVAL", data.MaxInterval, &s.maxInterval},
+		{"max_elapsed_time", "SEQERA_RETRY_MAX_ELAPSED_TIME", data.MaxElapsedTime, &s.maxElapsedTime},
+	}
+	for _, d := range durations {
+		raw, source := stringSetting(d.value, d.env)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName(d.attr),
+				"Invalid Retry Configuration",
+				fmt.Sprintf("%s must be a non-negative duration such as \"30s\", got %q", source, raw),
+			)
+			continue
+		}
+		*d.target = parsed
+	}
+
+	if !data.Exponent.IsNull() && !data.Exponent.IsUnknown() {
+		s.exponent = data.Exponent.ValueFloat64()
+	} else if raw := os.Getenv("SEQERA_RETRY_EXPONENT"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || parsed < 1 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName("exponent"),
+				"Invalid Retry Configuration",
+				fmt.Sprintf("SEQERA_RETRY_EXPONENT must be a number >= 1, got %q", raw),
+			)
+		} else {
+			s.exponent = parsed
+		}
+	}
+
+	if !data.RetryConnectionErrors.IsNull() && !data.RetryConnectionErrors.IsUnknown() {
+		s.retryConnectionErrors = data.RetryConnectionErrors.ValueBool()
+	} else if raw := os.Getenv("SEQERA_RETRY_CONNECTION_ERRORS"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("retry").AtName("retry_connection_errors"),
+				"Invalid Retry Configuration",
+				fmt.Sprintf("SEQERA_RETRY_CONNECTION_ERRORS must be true or false, got %q", raw),
+			)
+		} else {
+			s.retryConnectionErrors = parsed
+		}
+	}
+
+	if !data.StatusCodes.IsNull() && !data.StatusCodes.IsUnknown() {
+		var codes []int64
+		diags.Append(data.StatusCodes.ElementsAs(ctx, &codes, false)...)
+		for _, code := range codes {
+			s.statusCodes = append(s.statusCodes, int(code))
+		}
+	} else if raw := os.Getenv("SEQERA_RETRY_STATUS_CODES"); raw != "" {
+		for _, field := range strings.Split(raw, ",") {
+			code, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil || code < 400 || code > 599 {
+				diags.AddAttributeError(
+					path.Root("retry").AtName("status_codes"),
+					"Invalid Retry Configuration",
+					fmt.Sprintf("SEQERA_RETRY_STATUS_CODES must be a comma-separated list of HTTP status codes between 400 and 599, got %q", raw),
+				)
+				break
+			}
+			s.statusCodes = append(s.statusCodes, code)
+		}
+	}
+
+	if s.maxInterval < s.initialInterval {
+		diags.AddAttributeError(
+			path.Root("retry").AtName("max_interval"),
+			"Invalid Retry Configuration",
+			fmt.Sprintf("max_interval (%s) must not be shorter than initial_interval (%s)", s.maxInterval, s.initialInterval),
+		)
+	}
+
+	return s, diags
+}
+
+// stringSetting returns the configured attribute value, falling back to the
+// environment variable, along with a label naming where the value came from
+// for error messages.
+func stringSetting(value types.String, envVar string) (string, string) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString(), "value"
+	}
+	return os.Getenv(envVar), envVar
+}
+
+// sdkConfig converts the settings to the shape expected by sdk.WithRetryConfig.
+func (s retrySettings) sdkConfig() retry.Config {
+	return retry.Config{
+		Strategy: "backoff",
+		Backoff: &retry.BackoffStrategy{
+			InitialInterval: int(s.initialInterval / time.Millisecond),
+			MaxInterval:     int(s.maxInterval / time.Millisecond),
+			Exponent:        s.exponent,
+			MaxElapsedTime:  int(s.maxElapsedTime / time.Millisecond),
+		},
+		RetryConnectionErrors: s.retryConnectionErrors,
+	}
+}
+
+// additionalStatusCodes returns the configured codes the generated SDK does
+// not already retry on its own.
+func (s retrySettings) additionalStatusCodes() []int {
+	var codes []int
+	for _, code := range s.statusCodes {
+		if !slices.Contains(sdkRetryStatusCodes, code) && !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// wrapTransport adds a retrying layer for status codes outside the SDK's
+// built-in set. Generated operations hard-code their retryable codes, so
+// extra codes have to be handled below the SDK, at the transport.
+func (s retrySettings) wrapTransport(transport http.RoundTripper) http.RoundTripper {
+	codes := s.additionalStatusCodes()
+	if len(codes) == 0 {
+		return transport
+	}
+	return &retryStatusTransport{
+		transport:   transport,
+		settings:    s,
+		statusCodes: codes,
+	}
+}
+
+// retryStatusTransport re-sends requests whose response status is one of
+// statusCodes, backing off with the same curve as the SDK retry loop. Once
+// the budget is spent the last response is returned unchanged so the caller
+// reports it as usual.
+type retryStatusTransport struct {
+	transport   http.RoundTripper
+	settings    retrySettings
+	statusCodes []int
+}
+
+func (t *retryStatusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.transport.RoundTrip(req)
+		if err != nil || !slices.Contains(t.statusCodes, res.StatusCode) {
+			return res, err
+		}
+
+		// A body that cannot be replayed cannot be retried.
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return res, nil
+		}
+
+		wait := t.settings.backoff(attempt)
+		if time.Since(start)+wait > t.settings.maxElapsedTime {
+			return res, nil
+		}
+
+		tflog.Debug(ctx, "Retrying HTTP request", map[string]interface{}{
+			"status_code": res.StatusCode,
+			"attempt":     attempt + 1,
+			"wait":        wait.String(),
+		})
+		res.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns the wait before retry number attempt+1, following the same
+// formula as the SDK retry loop: initial * (attempt+1)^exponent with ±25%
+// jitter, capped at maxInterval.
+func (s retrySettings) backoff(attempt int) time.Duration {
+	interval := float64(s.initialInterval) * math.Pow(float64(attempt+1), s.exponent)
+	jitter := rand.Float64() * 0.25 * interval
+	if rand.Float64() < 0.5 {
+		jitter = -jitter
+	}
+	interval += jitter
+	if interval <= 0 {
+		interval = float64(s.initialInterval)
+	}
+	if interval > float64(s.maxInterval) {
+		interval = float64(s.maxInterval)
+	}
+	return time.Duration(interval)
+}
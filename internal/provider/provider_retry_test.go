@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveRetrySettingsPrecedence(t *testing.T) {
+	t.Setenv("SEQERA_RETRY_MAX_INTERVAL", "10s")
+	t.Setenv("SEQERA_RETRY_INITIAL_INTERVAL", "2s")
+	t.Setenv("SEQERA_RETRY_STATUS_CODES", "500, 520")
+
+	s, diags := resolveRetrySettings(context.Background(), &RetryModel{
+		InitialInterval:       types.StringValue("1s"),
+		MaxInterval:           types.StringNull(),
+		MaxElapsedTime:        types.StringNull(),
+		Exponent:              types.Float64Null(),
+		StatusCodes:           types.ListNull(types.Int64Type),
+		RetryConnectionErrors: types.BoolValue(false),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if s.initialInterval != time.Second {
+		t.Errorf("initial_interval: attribute should win over env, got %s", s.initialInterval)
+	}
+	if s.maxInterval != 10*time.Second {
+		t.Errorf("max_interval: env should win over default, got %s", s.maxInterval)
+	}
+	if s.maxElapsedTime != defaultRetryMaxElapsedTime {
+		t.Errorf("max_elapsed_time: expected default, got %s", s.maxElapsedTime)
+	}
+	if s.retryConnectionErrors {
+		t.Errorf("retry_connection_errors: expected false from attribute")
+	}
+	if got := s.additionalStatusCodes(); len(got) != 2 || got[0] != 500 || got[1] != 520 {
+		t.Errorf("status codes: got %v", got)
+	}
+
+	cfg := s.sdkConfig()
+	if cfg.Backoff.InitialInterval != 1000 || cfg.Backoff.MaxInterval != 10000 {
+		t.Errorf("sdk config intervals not in milliseconds: %+v", cfg.Backoff)
+	}
+}
+
+func TestResolveRetrySettingsInvalidEnv(t *testing.T) {
+	t.Setenv("SEQERA_RETRY_EXPONENT", "fast")
+
+	_, diags := resolveRetrySettings(context.Background(), nil)
+	if !diags.HasError() {
+		t.Fatal("expected an error for a non-numeric SEQERA_RETRY_EXPONENT")
+	}
+}
+
+func TestRetryStatusTransportRetriesAdditionalCodes(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s := retrySettings{
+		initialInterval: time.Millisecond,
+		maxInterval:     5 * time.Millisecond,
+		maxElapsedTime:  time.Second,
+		exponent:        1,
+		statusCodes:     []int{500},
+	}
+	client := &http.Client{Transport: s.wrapTransport(http.DefaultTransport)}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"name":"x"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected 200 after retries, got %d", res.StatusCode)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls.Load())
+	}
+}
+
+func TestRetryStatusTransportSkipsSDKCodes(t *testing.T) {
+	s := retrySettings{statusCodes: []int{429, 503}}
+	if _, ok := s.wrapTransport(http.DefaultTransport).(*retryStatusTransport); ok {
+		t.Error("codes already retried by the SDK must not add a transport layer")
+	}
+}
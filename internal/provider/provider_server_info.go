@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// serviceInfoTimeout bounds the capability probe, retries included, so an
+// unreachable platform does not hold Configure for the full retry budget;
+// the first real API call reports connection problems properly.
+const serviceInfoTimeout = 15 * time.Second
+
+// detectServerInfo records the platform version and feature flags for the
+// version-gated plan checks in common.RequireServerVersion. A failure is not
+// fatal: the checks are then skipped and the API has the last word.
+func detectServerInfo(ctx context.Context, client *sdk.Seqera) {
+	res, err := client.ServiceInfo.Info(ctx, operations.WithOperationTimeout(serviceInfoTimeout))
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the Seqera Platform service info; version checks are disabled", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if res.StatusCode != 200 || res.ServiceInfoResponse == nil {
+		tflog.Warn(ctx, "Unable to read the Seqera Platform service info; version checks are disabled", map[string]interface{}{
+			"status_code": res.StatusCode,
+		})
+		return
+	}
+
+	info := common.NewServerInfo(res.ServiceInfoResponse.ServiceInfo)
+	tflog.Debug(ctx, "Connected to Seqera Platform", map[string]interface{}{
+		"version":     info.Version,
+		"api_version": info.APIVersion,
+		"cloud":       info.Cloud,
+	})
+	common.SetServerInfo(client, info)
+}
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// Adoption of existing objects for the generated name-keyed resources. When
+// the provider sets `adopt_on_conflict` and a create fails with 409, Create
+// looks the object up by name, takes it into state and updates it to match
+// the plan through the resource's own Update, so an apply interrupted after
+// the object was created heals on the next run instead of failing.
+
+// adoption describes how to find the existing object a create conflicted
+// with.
+type adoption struct {
+	// kind names the object in diagnostics, such as "compute environment".
+	kind string
+
+	// name and scope are the paths of the name attribute and of the
+	// `workspace_id` or `org_id` attribute it is unique within.
+	name  path.Path
+	scope path.Path
+
+	// id is the path of the attribute find fills.
+	id path.Path
+
+	// find returns the ID of the object named name, or nil if there is none.
+	// plan carries any other attribute keying the object.
+	find func(ctx context.Context, client *sdk.Seqera, scopeID int64, name string, plan tfsdk.Plan) (attr.Value, error)
+}
+
+var (
+	computeEnvAdoption = adoption{
+		kind:  "compute environment",
+		name:  path.Root("name"),
+		scope: path.Root("workspace_id"),
+		id:    path.Root("compute_env_id"),
+		find:  findComputeEnvByName,
+	}
+	// seqera_compute_env nests the name in its compute_env object.
+	computeEnvObjectAdoption = adoption{
+		kind:  "compute environment",
+		name:  path.Root("compute_env").AtName("name"),
+		scope: path.Root("workspace_id"),
+		id:    path.Root("compute_env_id"),
+		find:  findComputeEnvByName,
+	}
+	credentialsAdoption = adoption{
+		kind:  "credential",
+		name:  path.Root("name"),
+		scope: path.Root("workspace_id"),
+		id:    path.Root("credentials_id"),
+		find:  findCredentialsByName,
+	}
+	labelAdoption = adoption{
+		kind:  "label",
+		name:  path.Root("name"),
+		scope: path.Root("workspace_id"),
+		id:    path.Root("label_id"),
+		find:  findLabelByName,
+	}
+	pipelineAdoption = adoption{
+		kind:  "pipeline",
+		name:  path.Root("name"),
+		scope: path.Root("workspace_id"),
+		id:    path.Root("pipeline_id"),
+		find:  findPipelineByName,
+	}
+	pipelineSecretAdoption = adoption{
+		kind:  "pipeline secret",
+		name:  path.Root("name"),
+		scope: path.Root("workspace_id"),
+		id:    path.Root("secret_id"),
+		find:  findPipelineSecretByName,
+	}
+	teamAdoption = adoption{
+		kind:  "team",
+		name:  path.Root("name"),
+		scope: path.Root("org_id"),
+		id:    path.Root("team_id"),
+		find:  findTeamByName,
+	}
+	workspaceAdoption = adoption{
+		kind:  "workspace",
+		name:  path.Root("name"),
+		scope: path.Root("org_id"),
+		id:    path.Root("id"),
+		find:  findWorkspaceByName,
+	}
+)
+
+// adoptOnConflict takes the object a create conflicted with into state, then
+// updates it to match the plan with r's Update. It returns false, leaving
+// the conflict to be reported as usual, when `adopt_on_conflict` is off or
+// there is no object of that name.
+func adoptOnConflict(ctx context.Context, client *sdk.Seqera, r resource.Resource, a adoption, req resource.CreateRequest, resp *resource.CreateResponse) bool {
+	if !common.ProviderDefaultsFor(client).AdoptOnConflict {
+		return false
+	}
+
+	var name types.String
+	var scope types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, a.name, &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, a.scope, &scope)...)
+	if resp.Diagnostics.HasError() {
+		return true
+	}
+
+	id, err := a.find(ctx, client, scope.ValueInt64(), name.ValueString(), req.Plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Adopt Existing Object", err.Error())
+		return true
+	}
+	if id == nil {
+		return false
+	}
+
+	known, err := nullUnknowns(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Adopt Existing Object", err.Error())
+		return true
+	}
+	state := tfsdk.State{Schema: req.Plan.Schema, Raw: known}
+	resp.Diagnostics.Append(state.SetAttribute(ctx, a.id, id)...)
+	if resp.Diagnostics.HasError() {
+		return true
+	}
+
+	updateResp := &resource.UpdateResponse{
+		State:    tfsdk.State{Schema: req.Plan.Schema, Raw: known.Copy()},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	r.Update(ctx, resource.UpdateRequest{
+		Config:       req.Config,
+		Plan:         req.Plan,
+		State:        state,
+		ProviderMeta: req.ProviderMeta,
+	}, updateResp)
+	resp.Diagnostics.Append(updateResp.Diagnostics...)
+	if resp.Diagnostics.HasError() {
+		// Leave nothing in state: a tainted adopted object would be
+		// destroyed by the next apply, which retries the adoption instead.
+		resp.State.RemoveResource(ctx)
+		return true
+	}
+
+	resp.State = updateResp.State
+	if resp.State.Raw, err = nullUnknowns(resp.State.Raw); err != nil {
+		resp.Diagnostics.AddError("Unable to Adopt Existing Object", err.Error())
+		return true
+	}
+	resp.Diagnostics.AddWarning(
+		"Adopted Existing Object",
+		fmt.Sprintf("A %s named %q already existed, so it was taken into state and updated to match the configuration instead of being created.", a.kind, name.ValueString()),
+	)
+	return true
+}
+
+// nullUnknowns replaces the unknown values of a plan with nulls, so computed
+// attributes the API does not return end up null in state rather than
+// unknown, which Terraform rejects after apply.
+func nullUnknowns(value tftypes.Value) (tftypes.Value, error) {
+	return tftypes.Transform(value, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+}
+
+func findComputeEnvByName(ctx context.Context, client *sdk.Seqera, workspaceID int64, name string, _ tfsdk.Plan) (attr.Value, error) {
+	res, err := client.ComputeEnvs.ListComputeEnvs(ctx, operations.ListComputeEnvsRequest{WorkspaceID: &workspaceID})
+	if err != nil {
+		return nil, fmt.Errorf("listing compute environments: %w", err)
+	}
+	if res.StatusCode != http.StatusOK || res.ListComputeEnvsResponse == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "listing compute environments", res.RawResponse)
+	}
+	for _, ce := range res.ListComputeEnvsResponse.ComputeEnvs {
+		if ce.ID != nil && ce.Name != nil && *ce.Name == name {
+			return types.StringValue(*ce.ID), nil
+		}
+	}
+	return nil, nil
+}
+
+func findCredentialsByName(ctx context.Context, client *sdk.Seqera, workspaceID int64, name string, _ tfsdk.Plan) (attr.Value, error) {
+	res, err := client.Credentials.ListCredentials(ctx, operations.ListCredentialsDataSourceRequest{WorkspaceID: &workspaceID})
+	if err != nil {
+		return nil, fmt.Errorf("listing credentials: %w", err)
+	}
+	if res.StatusCode != http.StatusOK || res.ListCredentialsDataSourceResponse == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "listing credentials", res.RawResponse)
+	}
+	for _, credential := range res.ListCredentialsDataSourceResponse.Credentials {
+		if credential.ID != nil && credential.Name != nil && *credential.Name == name {
+			return types.StringValue(*credential.ID), nil
+		}
+	}
+	return nil, nil
+}
+
+// findLabelByName matches the planned value too, since a label name may
+// carry several values.
+func findLabelByName(ctx context.Context, client *sdk.Seqera, workspaceID int64, name string, plan tfsdk.Plan) (attr.Value, error) {
+	var value types.String
+	if diags := plan.GetAttribute(ctx, path.Root("value"), &value); diags.HasError() {
+		return nil, fmt.Errorf("reading the planned label value")
+	}
+	label, err := findLabel(ctx, client, workspaceID, name, func(label *shared.LabelDbDto) bool {
+		return label.ID != nil && label.Name != nil && *label.Name == name &&
+			types.StringPointerValue(label.Value).ValueString() == value.ValueString()
+	})
+	if err != nil || label == nil {
+		return nil, err
+	}
+	return types.Int64Value(*label.ID), nil
+}
+
+func findPipelineByName(ctx context.Context, client *sdk.Seqera, workspaceID int64, name string, _ tfsdk.Plan) (attr.Value, error) {
+	pipeline, err := common.PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]shared.PipelineDbDto, int64, error) {
+			res, err := client.Pipelines.ListPipelines(ctx, operations.ListPipelinesRequest{
+				WorkspaceID: &workspaceID,
+				Search:      &name,
+				Max:         &max,
+				Offset:      &offset,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing pipelines: %w", err)
+			}
+			if res.StatusCode != http.StatusOK || res.ListPipelinesResponse == nil {
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing pipelines", res.RawResponse)
+			}
+			var totalSize int64
+			if res.ListPipelinesResponse.TotalSize != nil {
+				totalSize = *res.ListPipelinesResponse.TotalSize
+			}
+			return res.ListPipelinesResponse.Pipelines, totalSize, nil
+		},
+		func(pipeline *shared.PipelineDbDto) bool {
+			return pipeline.PipelineID != nil && pipeline.Name != nil && *pipeline.Name == name
+		},
+	)
+	if err != nil || pipeline == nil {
+		return nil, err
+	}
+	return types.Int64Value(*pipeline.PipelineID), nil
+}
+
+func findPipelineSecretByName(ctx context.Context, client *sdk.Seqera, workspaceID int64, name string, _ tfsdk.Plan) (attr.Value, error) {
+	res, err := client.PipelineSecrets.ListPipelineSecrets(ctx, operations.ListPipelineSecretsRequest{WorkspaceID: &workspaceID})
+	if err != nil {
+		return nil, fmt.Errorf("listing pipeline secrets: %w", err)
+	}
+	if res.StatusCode != http.StatusOK || res.ListPipelineSecretsResponse == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "listing pipeline secrets", res.RawResponse)
+	}
+	for _, secret := range res.ListPipelineSecretsResponse.PipelineSecrets {
+		if secret.ID != nil && secret.Name == name {
+			return types.Int64Value(*secret.ID), nil
+		}
+	}
+	return nil, nil
+}
+
+func findTeamByName(ctx context.Context, client *sdk.Seqera, orgID int64, name string, _ tfsdk.Plan) (attr.Value, error) {
+	team, err := common.PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]shared.TeamDbDto, int64, error) {
+			res, err := client.Teams.ListOrganizationTeams(ctx, operations.ListOrganizationTeamsRequest{
+				OrgID:  orgID,
+				Search: &name,
+				Max:    &max,
+				Offset: &offset,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing teams: %w", err)
+			}
+			if res.StatusCode != http.StatusOK || res.ListTeamResponse == nil {
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing teams", res.RawResponse)
+			}
+			var totalSize int64
+			if res.ListTeamResponse.TotalSize != nil {
+				totalSize = *res.ListTeamResponse.TotalSize
+			}
+			return res.ListTeamResponse.Teams, totalSize, nil
+		},
+		func(team *shared.TeamDbDto) bool {
+			return team.TeamID != nil && team.Name != nil && *team.Name == name
+		},
+	)
+	if err != nil || team == nil {
+		return nil, err
+	}
+	return types.Int64Value(*team.TeamID), nil
+}
+
+func findWorkspaceByName(ctx context.Context, client *sdk.Seqera, orgID int64, name string, _ tfsdk.Plan) (attr.Value, error) {
+	res, err := client.Workspaces.ListWorkspaces(ctx, operations.ListWorkspacesRequest{OrgID: orgID})
+	if err != nil {
+		return nil, fmt.Errorf("listing workspaces: %w", err)
+	}
+	if res.StatusCode != http.StatusOK || res.ListWorkspacesResponse == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "listing workspaces", res.RawResponse)
+	}
+	for _, workspace := range res.ListWorkspacesResponse.Workspaces {
+		if workspace.ID != nil && workspace.Name != nil && *workspace.Name == name {
+			return types.Int64Value(*workspace.ID), nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+func TestPipelineSecretAdoptOnConflict(t *testing.T) {
+	var updated bool
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /pipeline-secrets":
+			w.WriteHeader(http.StatusConflict)
+			_ = json.NewEncoder(w).Encode(map[string]any{"message": "A pipeline secret with name 'TOKEN' already exists"})
+		case "GET /pipeline-secrets":
+			_ = json.NewEncoder(w).Encode(map[string]any{"pipelineSecrets": []map[string]any{
+				{"id": 4, "name": "OTHER"},
+				{"id": 5, "name": "TOKEN"},
+			}})
+		case "PUT /pipeline-secrets/5":
+			updated = true
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /pipeline-secrets/5":
+			_ = json.NewEncoder(w).Encode(map[string]any{"pipelineSecret": map[string]any{"id": 5, "name": "TOKEN"}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer api.Close()
+
+	ctx := context.Background()
+	for name, adopt := range map[string]bool{"adopted": true, "conflict reported": false} {
+		t.Run(name, func(t *testing.T) {
+			updated = false
+			client := sdk.New(sdk.WithServerURL(api.URL))
+			common.SetProviderDefaults(client, common.ProviderDefaults{AdoptOnConflict: adopt})
+			secrets, diags := newConfiguredResource(ctx, NewPipelineSecretResource, client)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var schema resource.SchemaResponse
+			secrets.Schema(ctx, resource.SchemaRequest{}, &schema)
+			objectType := schema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for attribute, attributeType := range objectType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+			}
+			values["name"] = tftypes.NewValue(tftypes.String, "TOKEN")
+			values["value"] = tftypes.NewValue(tftypes.String, "s3cr3t")
+			values["workspace_id"] = tftypes.NewValue(tftypes.Number, 7)
+			plan := tfsdk.Plan{Schema: schema.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: schema.Schema, Raw: plan.Raw.Copy()}}
+			secrets.Create(ctx, resource.CreateRequest{Plan: plan, Config: tfsdk.Config{Schema: schema.Schema, Raw: plan.Raw}}, resp)
+
+			if !adopt {
+				if !resp.Diagnostics.HasError() || updated {
+					t.Fatalf("expected the conflict to be reported, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !updated {
+				t.Error("expected the existing secret to be updated")
+			}
+			if !resp.State.Raw.IsFullyKnown() {
+				t.Error("expected no unknown values in state")
+			}
+			var secretID int64
+			resp.State.GetAttribute(ctx, path.Root("secret_id"), &secretID)
+			if secretID != 5 {
+				t.Errorf("expected secret 5 in state, got %d", secretID)
+			}
+		})
+	}
+}
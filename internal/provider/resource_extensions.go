@@ -0,0 +1,584 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/responses"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// Extensions of the generated resources. The *_resource.go files stay as
+// Speakeasy generates them; ExtendedProvider wraps each generated resource
+// in an extendedResource, which adds what the generator cannot express:
+//
+//   - the resource identity (resource_identity.go);
+//   - API errors reported from the recorded response (api_errors.go and
+//     internal/sdk/responses);
+//   - the compute environment `timeouts` block (computeenv_timeouts.go);
+//   - workspace_id defaulting to the provider default_workspace
+//     (resource_modify_plan.go);
+//   - the provider default_labels (default_labels.go);
+//   - adoption of existing objects on conflict (resource_adopt.go);
+//   - the datasets, labels and tokens operations the generated ones cannot
+//     implement (resource_import.go).
+//
+// The generated operations run against the generated schema: the wrapper
+// strips the attributes it adds before calling them, and restores them from
+// the plan or prior state afterwards.
+
+// resourceExtension describes what extendedResource adds to a generated
+// resource.
+type resourceExtension struct {
+	identity common.IdentityAttributes
+
+	// noIdentityImport leaves import to the generated ImportState, for
+	// resources that cannot be imported.
+	noIdentityImport bool
+
+	// timeouts adds the compute environment `timeouts` block.
+	timeouts bool
+
+	// adoption, if set, adopts the existing object a create conflicts with.
+	adoption *adoption
+
+	// defaultLabels, if set, attaches the provider default_labels and adds
+	// `ignore_default_labels`.
+	defaultLabels *defaultLabelTarget
+
+	// create, read and importState, if set, replace the generated operation.
+	create      func(context.Context, *sdk.Seqera, resource.CreateRequest, *resource.CreateResponse)
+	read        func(context.Context, *sdk.Seqera, resource.ReadRequest, *resource.ReadResponse)
+	importState func(context.Context, *sdk.Seqera, resource.ImportStateRequest, *resource.ImportStateResponse)
+}
+
+// resourceExtensions maps the type name of each generated resource to its
+// extension.
+var resourceExtensions = map[string]resourceExtension{
+	"seqera_action": {
+		identity: actionIdentity,
+		defaultLabels: &defaultLabelTarget{
+			target:   common.LabelTargetAction,
+			id:       path.Root("action_id"),
+			labelIDs: path.Root("launch").AtName("label_ids"),
+		},
+	},
+	"seqera_aws_batch_ce":                  {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_aws_cloud_ce":                  {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_aws_compute_env":               {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_aws_credential":                {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_azure_batch_ce":                {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_azure_cloud_ce":                {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_azure_cloud_credential":        {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_azure_credential":              {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_azure_entra_credential":        {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_bitbucket_credential":          {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_codecommit_credential":         {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_compute_env":                   {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvObjectAdoption},
+	"seqera_container_registry_credential": {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_credential":                    {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_custom_role":                   {identity: customRoleIdentity},
+	"seqera_data_link":                     {identity: dataLinkIdentity},
+	"seqera_datasets": {
+		identity: datasetIdentity,
+		defaultLabels: &defaultLabelTarget{
+			target: common.LabelTargetDataset,
+			id:     path.Root("id"),
+		},
+		read:        readDataset,
+		importState: importDataset,
+	},
+	"seqera_gcp_batch_ce":          {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_gcp_cloud_ce":          {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_gitea_credential":      {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_github_app_credential": {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_github_credential":     {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_gitlab_credential":     {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_google_credential":     {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_kubernetes_credential": {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_labels":                {identity: labelIdentity, adoption: &labelAdoption, read: readLabel, importState: importLabel},
+	"seqera_managed_compute_ce":    {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_orgs":                  {identity: orgIdentity},
+	"seqera_pipeline": {
+		identity: pipelineIdentity,
+		adoption: &pipelineAdoption,
+		defaultLabels: &defaultLabelTarget{
+			target:   common.LabelTargetPipeline,
+			id:       path.Root("pipeline_id"),
+			labelIDs: path.Root("label_ids"),
+		},
+	},
+	"seqera_pipeline_secret":        {identity: pipelineSecretIdentity, adoption: &pipelineSecretAdoption},
+	"seqera_primary_compute_env":    {identity: primaryComputeEnvIdentity, noIdentityImport: true},
+	"seqera_slurm_ce":               {identity: computeEnvIdentity, timeouts: true, adoption: &computeEnvAdoption},
+	"seqera_ssh_credential":         {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_studios":                {identity: studioIdentity},
+	"seqera_teams":                  {identity: teamIdentity, adoption: &teamAdoption},
+	"seqera_tokens":                 {identity: tokenIdentity, create: createToken, read: readToken, importState: importToken},
+	"seqera_tower_agent_credential": {identity: credentialIdentity, adoption: &credentialsAdoption},
+	"seqera_workflows": {
+		identity: workflowIdentity,
+		defaultLabels: &defaultLabelTarget{
+			target:   common.LabelTargetWorkflow,
+			id:       path.Root("workflow_id"),
+			labelIDs: path.Root("label_ids"),
+		},
+	},
+	"seqera_workspace": {identity: workspaceIdentity, adoption: &workspaceAdoption},
+}
+
+// extendResource wraps r with its extension, or returns it as it is if it
+// has none.
+func extendResource(r resource.Resource) resource.Resource {
+	var metaResp resource.MetadataResponse
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "seqera"}, &metaResp)
+	ext, ok := resourceExtensions[metaResp.TypeName]
+	if !ok {
+		return r
+	}
+	return &extendedResource{Resource: r, ext: ext}
+}
+
+// newConfiguredResource returns the resource newResource registers, extended
+// and configured with client as the provider does, for use outside the
+// provider's Resources, such as in list resources.
+func newConfiguredResource(ctx context.Context, newResource func() resource.Resource, client *sdk.Seqera) (resource.Resource, diag.Diagnostics) {
+	r := extendResource(newResource())
+	var resp resource.ConfigureResponse
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resp)
+	}
+	return r, resp.Diagnostics
+}
+
+type extendedResource struct {
+	resource.Resource
+	ext    resourceExtension
+	client *sdk.Seqera
+}
+
+var (
+	_ resource.ResourceWithConfigure    = &extendedResource{}
+	_ resource.ResourceWithIdentity     = &extendedResource{}
+	_ resource.ResourceWithImportState  = &extendedResource{}
+	_ resource.ResourceWithModifyPlan   = &extendedResource{}
+	_ resource.ResourceWithMoveState    = &extendedResource{}
+	_ resource.ResourceWithUpgradeState = &extendedResource{}
+)
+
+func (r *extendedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.Resource.Schema(ctx, req, resp)
+
+	attributes := maps.Clone(resp.Schema.Attributes)
+	if workspaceID, ok := attributes["workspace_id"].(schema.Int64Attribute); ok && workspaceID.Required {
+		workspaceID.Required = false
+		workspaceID.Optional = true
+		workspaceID.Computed = true
+		workspaceID.Description = withDefaultWorkspace(workspaceID.Description)
+		workspaceID.MarkdownDescription = withDefaultWorkspace(workspaceID.MarkdownDescription)
+		attributes["workspace_id"] = workspaceID
+	}
+	if r.ext.defaultLabels != nil {
+		attributes["ignore_default_labels"] = ignoreDefaultLabelsAttribute()
+	}
+	resp.Schema.Attributes = attributes
+
+	if r.ext.timeouts {
+		blocks := maps.Clone(resp.Schema.Blocks)
+		if blocks == nil {
+			blocks = map[string]schema.Block{}
+		}
+		blocks["timeouts"] = computeEnvTimeoutsBlock(ctx)
+		resp.Schema.Blocks = blocks
+	}
+}
+
+// withDefaultWorkspace documents that an omitted workspace_id is filled by
+// ModifyPlan, keeping the replacement note last.
+func withDefaultWorkspace(description string) string {
+	const requiresReplacement = " Requires replacement if changed."
+	if description == "" {
+		return ""
+	}
+	replaces := strings.HasSuffix(description, requiresReplacement)
+	description = strings.TrimSuffix(description, requiresReplacement)
+	if !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+	description += " Defaults to the provider default_workspace when omitted."
+	if replaces {
+		description += requiresReplacement
+	}
+	return description
+}
+
+func (r *extendedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if configurable, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		configurable.Configure(ctx, req, resp)
+	}
+	if client, _ := common.ConfigureClient(req.ProviderData); client != nil {
+		r.client = client
+	}
+}
+
+func (r *extendedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, r.ext.identity, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	inner := r.innerSchema(ctx, &resp.Diagnostics)
+	innerReq := req
+	innerReq.Config = tfsdk.Config{Schema: inner, Raw: innerValue(ctx, req.Config.Raw, inner, &resp.Diagnostics)}
+	innerReq.Plan = tfsdk.Plan{Schema: inner, Raw: innerValue(ctx, req.Plan.Raw, inner, &resp.Diagnostics)}
+	innerResp := &resource.CreateResponse{
+		State:    tfsdk.State{Schema: inner, Raw: innerValue(ctx, resp.State.Raw, inner, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	opCtx := ctx
+	if r.ext.timeouts {
+		var t timeouts.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+		createTimeout, diags := t.Create(ctx, computeEnvDefaultCreateTimeout)
+		resp.Diagnostics.Append(diags...)
+		opCtx = computeEnvPollingContext(ctx, createTimeout)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, recorder := responses.WithRecorder(opCtx)
+	if r.ext.create != nil {
+		r.ext.create(opCtx, r.client, innerReq, innerResp)
+	} else {
+		r.Resource.Create(opCtx, innerReq, innerResp)
+	}
+	last := recorder.Last()
+
+	if innerResp.Diagnostics.HasError() && r.ext.adoption != nil && last != nil && last.StatusCode == http.StatusConflict {
+		if adoptOnConflict(ctx, r.client, r, *r.ext.adoption, req, resp) {
+			return
+		}
+	}
+	resp.Diagnostics.Append(reportAPIErrors(ctx, innerResp.Diagnostics, req.Plan.Raw.Type(), last)...)
+	resp.State.Raw = extendedValue(ctx, innerResp.State.Raw, resp.State.Schema.Type().TerraformType(ctx), req.Plan.Raw, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() || r.ext.defaultLabels == nil {
+		return
+	}
+	resp.Diagnostics.Append(r.ext.defaultLabels.attach(ctx, r.client, resp.State)...)
+}
+
+func (r *extendedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, r.ext.identity, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	inner := r.innerSchema(ctx, &resp.Diagnostics)
+	innerReq := req
+	innerReq.State = tfsdk.State{Schema: inner, Raw: innerValue(ctx, req.State.Raw, inner, &resp.Diagnostics)}
+	innerResp := &resource.ReadResponse{
+		State:    tfsdk.State{Schema: inner, Raw: innerValue(ctx, resp.State.Raw, inner, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, recorder := responses.WithRecorder(ctx)
+	if r.ext.read != nil {
+		r.ext.read(opCtx, r.client, innerReq, innerResp)
+	} else {
+		r.Resource.Read(opCtx, innerReq, innerResp)
+	}
+
+	resp.Diagnostics.Append(reportAPIErrors(ctx, innerResp.Diagnostics, req.State.Raw.Type(), recorder.Last())...)
+	resp.State.Raw = extendedValue(ctx, innerResp.State.Raw, resp.State.Schema.Type().TerraformType(ctx), req.State.Raw, &resp.Diagnostics)
+	resp.Deferred = innerResp.Deferred
+}
+
+func (r *extendedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, r.ext.identity, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	inner := r.innerSchema(ctx, &resp.Diagnostics)
+	innerReq := req
+	innerReq.Config = tfsdk.Config{Schema: inner, Raw: innerValue(ctx, req.Config.Raw, inner, &resp.Diagnostics)}
+	innerReq.Plan = tfsdk.Plan{Schema: inner, Raw: innerValue(ctx, req.Plan.Raw, inner, &resp.Diagnostics)}
+	innerReq.State = tfsdk.State{Schema: inner, Raw: innerValue(ctx, req.State.Raw, inner, &resp.Diagnostics)}
+	innerResp := &resource.UpdateResponse{
+		State:    tfsdk.State{Schema: inner, Raw: innerValue(ctx, resp.State.Raw, inner, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, recorder := responses.WithRecorder(ctx)
+	r.Resource.Update(opCtx, innerReq, innerResp)
+
+	resp.Diagnostics.Append(reportAPIErrors(ctx, innerResp.Diagnostics, req.Plan.Raw.Type(), recorder.Last())...)
+	resp.State.Raw = extendedValue(ctx, innerResp.State.Raw, resp.State.Schema.Type().TerraformType(ctx), req.Plan.Raw, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() || r.ext.defaultLabels == nil {
+		return
+	}
+	resp.Diagnostics.Append(r.ext.defaultLabels.update(ctx, r.client, req, resp.State)...)
+}
+
+func (r *extendedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	inner := r.innerSchema(ctx, &resp.Diagnostics)
+	innerReq := req
+	innerReq.State = tfsdk.State{Schema: inner, Raw: innerValue(ctx, req.State.Raw, inner, &resp.Diagnostics)}
+	innerResp := &resource.DeleteResponse{
+		State:    tfsdk.State{Schema: inner, Raw: innerValue(ctx, resp.State.Raw, inner, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	opCtx := ctx
+	if r.ext.timeouts {
+		var t timeouts.Value
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+		deleteTimeout, diags := t.Delete(ctx, computeEnvDefaultDeleteTimeout)
+		resp.Diagnostics.Append(diags...)
+		opCtx = computeEnvPollingContext(ctx, deleteTimeout)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opCtx, recorder := responses.WithRecorder(opCtx)
+	r.Resource.Delete(opCtx, innerReq, innerResp)
+
+	resp.Diagnostics.Append(reportAPIErrors(ctx, innerResp.Diagnostics, req.State.Raw.Type(), recorder.Last())...)
+	resp.State.Raw = extendedValue(ctx, innerResp.State.Raw, resp.State.Schema.Type().TerraformType(ctx), req.State.Raw, &resp.Diagnostics)
+}
+
+func (r *extendedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !r.ext.noIdentityImport && common.ImportStateFromIdentity(ctx, r.client, r.ext.identity, req, resp) {
+		return
+	}
+	if r.ext.importState != nil {
+		r.ext.importState(ctx, r.client, req, resp)
+		return
+	}
+	if importable, ok := r.Resource.(resource.ResourceWithImportState); ok {
+		importable.ImportState(ctx, req, resp)
+	}
+}
+
+func (r *extendedResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	if identifiable, ok := r.Resource.(resource.ResourceWithIdentity); ok {
+		identifiable.IdentitySchema(ctx, req, resp)
+	}
+}
+
+func (r *extendedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if modifiable, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		modifiable.ModifyPlan(ctx, req, resp)
+	}
+}
+
+// UpgradeState forwards the generated upgraders, which produce the extended
+// schema's state (stateupgrader_schemas.go).
+func (r *extendedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if upgradable, ok := r.Resource.(resource.ResourceWithUpgradeState); ok {
+		return upgradable.UpgradeState(ctx)
+	}
+	return nil
+}
+
+// MoveState runs the movers of the resource against the generated schema:
+// the attributes only the extended schema has are taken out of the source
+// state before, and put back into the target state after.
+func (r *extendedResource) MoveState(ctx context.Context) []resource.StateMover {
+	movable, ok := r.Resource.(resource.ResourceWithMoveState)
+	if !ok {
+		return nil
+	}
+	movers := movable.MoveState(ctx)
+	for i, mover := range movers {
+		move := mover.StateMover
+		movers[i].StateMover = func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			r.moveState(ctx, move, req, resp)
+		}
+	}
+	return movers
+}
+
+func (r *extendedResource) moveState(ctx context.Context, move func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse), req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	inner := r.innerSchema(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	outerType := resp.TargetState.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	innerType := inner.Type().TerraformType(ctx).(tftypes.Object)
+
+	extras := tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}
+	for name, typ := range outerType.AttributeTypes {
+		if _, ok := innerType.AttributeTypes[name]; !ok {
+			extras.AttributeTypes[name] = typ
+		}
+	}
+
+	innerReq := req
+	extraSource := map[string]json.RawMessage{}
+	if req.SourceRawState != nil && len(extras.AttributeTypes) > 0 {
+		var source map[string]json.RawMessage
+		if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+			resp.Diagnostics.AddError("Failed to unmarshal source state", err.Error())
+			return
+		}
+		innerSource := map[string]json.RawMessage{}
+		for name, value := range source {
+			if _, ok := extras.AttributeTypes[name]; ok {
+				extraSource[name] = value
+			} else {
+				innerSource[name] = value
+			}
+		}
+		innerJSON, err := json.Marshal(innerSource)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to unmarshal source state", err.Error())
+			return
+		}
+		innerReq.SourceRawState = &tfprotov6.RawState{JSON: innerJSON}
+	}
+
+	innerResp := &resource.MoveStateResponse{
+		TargetState:    tfsdk.State{Schema: inner, Raw: tftypes.NewValue(innerType, nil)},
+		TargetIdentity: resp.TargetIdentity,
+		TargetPrivate:  resp.TargetPrivate,
+	}
+	move(ctx, innerReq, innerResp)
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	if resp.Diagnostics.HasError() || innerResp.TargetState.Raw.IsNull() {
+		return
+	}
+
+	extraJSON, err := json.Marshal(extraSource)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to unmarshal source state", err.Error())
+		return
+	}
+	extraValue, err := (&tfprotov6.RawState{JSON: extraJSON}).Unmarshal(extras)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to unmarshal source state", err.Error())
+		return
+	}
+	resp.TargetState.Raw = extendedValue(ctx, innerResp.TargetState.Raw, outerType, extraValue, &resp.Diagnostics)
+	resp.TargetIdentity = innerResp.TargetIdentity
+	resp.TargetPrivate = innerResp.TargetPrivate
+}
+
+// innerSchema returns the generated schema of the resource.
+func (r *extendedResource) innerSchema(ctx context.Context, diags *diag.Diagnostics) schema.Schema {
+	var schemaResp resource.SchemaResponse
+	r.Resource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	diags.Append(schemaResp.Diagnostics...)
+	return schemaResp.Schema
+}
+
+// innerValue converts an object of the extended schema to the generated
+// schema, dropping the attributes the extension adds.
+func innerValue(ctx context.Context, value tftypes.Value, inner schema.Schema, diags *diag.Diagnostics) tftypes.Value {
+	innerType := inner.Type().TerraformType(ctx).(tftypes.Object)
+	if value.IsNull() {
+		return tftypes.NewValue(innerType, nil)
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(innerType, tftypes.UnknownValue)
+	}
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		diags.AddError("Unable to Convert Resource Data", err.Error())
+		return tftypes.NewValue(innerType, nil)
+	}
+	for name := range attributes {
+		if _, ok := innerType.AttributeTypes[name]; !ok {
+			delete(attributes, name)
+		}
+	}
+	return tftypes.NewValue(innerType, attributes)
+}
+
+// extendedValue converts an object of the generated schema to the extended
+// schema, taking the attributes the extension adds from source, an object
+// holding them, or leaving them null.
+func extendedValue(ctx context.Context, value tftypes.Value, outerType tftypes.Type, source tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	if value.IsNull() {
+		return tftypes.NewValue(outerType, nil)
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(outerType, tftypes.UnknownValue)
+	}
+	var attributes, sourceAttributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		diags.AddError("Unable to Convert Resource Data", err.Error())
+		return tftypes.NewValue(outerType, nil)
+	}
+	if !source.IsNull() && source.IsKnown() {
+		if err := source.As(&sourceAttributes); err != nil {
+			diags.AddError("Unable to Convert Resource Data", err.Error())
+			return tftypes.NewValue(outerType, nil)
+		}
+	}
+	for name, typ := range outerType.(tftypes.Object).AttributeTypes {
+		if _, ok := attributes[name]; ok {
+			continue
+		}
+		if sourceValue, ok := sourceAttributes[name]; ok {
+			attributes[name] = sourceValue
+		} else {
+			attributes[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+	return tftypes.NewValue(outerType, attributes)
+}
+
+// extendDataSource wraps the generated data sources whose failed calls are
+// reported from the recorded response.
+func extendDataSource(d datasource.DataSource) datasource.DataSource {
+	var metaResp datasource.MetadataResponse
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "seqera"}, &metaResp)
+	switch metaResp.TypeName {
+	case "seqera_credentials", "seqera_data_links":
+		return &extendedDataSource{DataSource: d}
+	}
+	return d
+}
+
+type extendedDataSource struct {
+	datasource.DataSource
+}
+
+var _ datasource.DataSourceWithConfigure = &extendedDataSource{}
+
+func (d *extendedDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if configurable, ok := d.DataSource.(datasource.DataSourceWithConfigure); ok {
+		configurable.Configure(ctx, req, resp)
+	}
+}
+
+func (d *extendedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	diags := resp.Diagnostics
+	resp.Diagnostics = nil
+
+	opCtx, recorder := responses.WithRecorder(ctx)
+	d.DataSource.Read(opCtx, req, resp)
+
+	resp.Diagnostics = append(diags, reportAPIErrors(ctx, resp.Diagnostics, req.Config.Raw.Type(), recorder.Last())...)
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+func TestExtendedResourceReportsAPIErrors(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(w).Encode(map[string]any{"message": "database unavailable"})
+	}))
+	defer api.Close()
+
+	resp := createWithAPI(t, NewPipelineSecretResource, api.URL, map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "TOKEN"),
+		"value":        tftypes.NewValue(tftypes.String, "s3cr3t"),
+		"workspace_id": tftypes.NewValue(tftypes.Number, 7),
+	})
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("expected one error, got %v", resp.Diagnostics)
+	}
+	want := "Status 500 while calling POST /pipeline-secrets: database unavailable"
+	if errs[0].Summary() != "Unexpected API response" || errs[0].Detail() != want {
+		t.Errorf("expected %q, got %q: %q", want, errs[0].Summary(), errs[0].Detail())
+	}
+}
+
+// The generated operations add an unredacted request/response dump to their
+// diagnostics; none of the secrets sent may reach the reported ones.
+func TestExtendedResourceRedactsAPIErrors(t *testing.T) {
+	resources := []struct {
+		name        string
+		newResource func() resource.Resource
+		values      map[string]tftypes.Value
+		secrets     []string
+	}{
+		{
+			name:        "aws credential",
+			newResource: NewAWSCredentialResource,
+			values: map[string]tftypes.Value{
+				"name":         tftypes.NewValue(tftypes.String, "aws"),
+				"access_key":   tftypes.NewValue(tftypes.String, "AKIAEXAMPLEKEY"),
+				"secret_key":   tftypes.NewValue(tftypes.String, "aws-s3cr3t-key"),
+				"workspace_id": tftypes.NewValue(tftypes.Number, 7),
+			},
+			secrets: []string{"AKIAEXAMPLEKEY", "aws-s3cr3t-key"},
+		},
+		{
+			name:        "container registry credential",
+			newResource: NewContainerRegistryCredentialResource,
+			values: map[string]tftypes.Value{
+				"name":         tftypes.NewValue(tftypes.String, "registry"),
+				"registry":     tftypes.NewValue(tftypes.String, "docker.io"),
+				"user_name":    tftypes.NewValue(tftypes.String, "robot"),
+				"password":     tftypes.NewValue(tftypes.String, "hunter2-password"),
+				"workspace_id": tftypes.NewValue(tftypes.Number, 7),
+			},
+			secrets: []string{"hunter2-password"},
+		},
+	}
+	responses := []struct {
+		name        string
+		status      int
+		contentType string
+	}{
+		// A status the operation does not handle.
+		{name: "unexpected status", status: http.StatusInternalServerError, contentType: "application/json"},
+		// A body the SDK cannot decode: a "failure to invoke API".
+		{name: "unknown content type", status: http.StatusOK, contentType: "text/plain"},
+	}
+
+	for _, r := range resources {
+		for _, res := range responses {
+			t.Run(r.name+"/"+res.name, func(t *testing.T) {
+				// The API echoes the request, as the platform does with the
+				// credential in some of its error responses.
+				api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					w.Header().Set("Content-Type", res.contentType)
+					w.WriteHeader(res.status)
+					_, _ = io.Copy(w, req.Body)
+				}))
+				defer api.Close()
+
+				resp := createWithAPI(t, r.newResource, api.URL, r.values)
+
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected an error")
+				}
+				for _, d := range resp.Diagnostics {
+					for _, secret := range r.secrets {
+						if strings.Contains(d.Summary(), secret) || strings.Contains(d.Detail(), secret) {
+							t.Errorf("diagnostic %q leaks %q: %s", d.Summary(), secret, d.Detail())
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+// createWithAPI runs Create on the extended newResource, configured with a
+// client of the API at url, for a plan holding values and leaving the other
+// attributes unknown.
+func createWithAPI(t *testing.T, newResource func() resource.Resource, url string, values map[string]tftypes.Value) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+	r := extendResource(newResource())
+	var configureResp resource.ConfigureResponse
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: sdk.New(sdk.WithServerURL(url))}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+	objectType := schema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	planValues := map[string]tftypes.Value{}
+	for attribute, attributeType := range objectType.AttributeTypes {
+		planValues[attribute] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+	}
+	for attribute, value := range values {
+		planValues[attribute] = value
+	}
+	plan := tfsdk.Plan{Schema: schema.Schema, Raw: tftypes.NewValue(objectType, planValues)}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schema.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan, Config: tfsdk.Config{Schema: schema.Schema, Raw: plan.Raw}}, resp)
+	return resp
+}
+
+func TestExtendedResourceMoveStateKeepsTimeouts(t *testing.T) {
+	ctx := context.Background()
+	ces := extendResource(NewAWSBatchCEResource()).(resource.ResourceWithMoveState)
+
+	var schema resource.SchemaResponse
+	ces.Schema(ctx, resource.SchemaRequest{}, &schema)
+	source, err := json.Marshal(map[string]any{
+		"compute_env_id": "ce-1",
+		"workspace_id":   7,
+		"timeouts":       map[string]any{"create": "30m"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &resource.MoveStateResponse{TargetState: tfsdk.State{
+		Schema: schema.Schema,
+		Raw:    tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil),
+	}}
+	ces.MoveState(ctx)[0].StateMover(ctx, resource.MoveStateRequest{
+		SourceTypeName: "seqera_compute_env",
+		SourceRawState: &tfprotov6.RawState{JSON: source},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var computeEnvID string
+	resp.TargetState.GetAttribute(ctx, path.Root("compute_env_id"), &computeEnvID)
+	if computeEnvID != "ce-1" {
+		t.Errorf("expected compute environment ce-1, got %q", computeEnvID)
+	}
+	var moved timeouts.Value
+	resp.TargetState.GetAttribute(ctx, path.Root("timeouts"), &moved)
+	if create, _ := moved.Create(ctx, 0); create != 30*time.Minute {
+		t.Errorf("expected the 30m create timeout to be moved, got %s", create)
+	}
+}
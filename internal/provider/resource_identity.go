@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// Resource identities for the generated resources. Speakeasy does not
+// generate identities, so they live here rather than in the *_resource.go
+// files: Create, Read and Update keep the identity in sync with
+// common.SetIdentityFromState, and ImportState accepts an `identity` through
+// common.ImportStateFromIdentity next to the older import ID formats.
+
+var (
+	_ resource.ResourceWithIdentity = &ActionResource{}
+	_ resource.ResourceWithIdentity = &AWSBatchCEResource{}
+	_ resource.ResourceWithIdentity = &AwsCloudCEResource{}
+	_ resource.ResourceWithIdentity = &AWSComputeEnvResource{}
+	_ resource.ResourceWithIdentity = &AWSCredentialResource{}
+	_ resource.ResourceWithIdentity = &AzureBatchCEResource{}
+	_ resource.ResourceWithIdentity = &AzureCloudCEResource{}
+	_ resource.ResourceWithIdentity = &AzureCloudCredentialResource{}
+	_ resource.ResourceWithIdentity = &AzureCredentialResource{}
+	_ resource.ResourceWithIdentity = &AzureEntraCredentialResource{}
+	_ resource.ResourceWithIdentity = &BitbucketCredentialResource{}
+	_ resource.ResourceWithIdentity = &CodecommitCredentialResource{}
+	_ resource.ResourceWithIdentity = &ComputeEnvResource{}
+	_ resource.ResourceWithIdentity = &ContainerRegistryCredentialResource{}
+	_ resource.ResourceWithIdentity = &CredentialResource{}
+	_ resource.ResourceWithIdentity = &CustomRoleResource{}
+	_ resource.ResourceWithIdentity = &DataLinkResource{}
+	_ resource.ResourceWithIdentity = &DatasetsResource{}
+	_ resource.ResourceWithIdentity = &GCPBatchCEResource{}
+	_ resource.ResourceWithIdentity = &GCPCloudCEResource{}
+	_ resource.ResourceWithIdentity = &GiteaCredentialResource{}
+	_ resource.ResourceWithIdentity = &GithubAppCredentialResource{}
+	_ resource.ResourceWithIdentity = &GithubCredentialResource{}
+	_ resource.ResourceWithIdentity = &GitlabCredentialResource{}
+	_ resource.ResourceWithIdentity = &GoogleCredentialResource{}
+	_ resource.ResourceWithIdentity = &KubernetesCredentialResource{}
+	_ resource.ResourceWithIdentity = &LabelsResource{}
+	_ resource.ResourceWithIdentity = &ManagedComputeCEResource{}
+	_ resource.ResourceWithIdentity = &OrgsResource{}
+	_ resource.ResourceWithIdentity = &PipelineResource{}
+	_ resource.ResourceWithIdentity = &PipelineSecretResource{}
+	_ resource.ResourceWithIdentity = &PrimaryComputeEnvResource{}
+	_ resource.ResourceWithIdentity = &SlurmCEResource{}
+	_ resource.ResourceWithIdentity = &SSHCredentialResource{}
+	_ resource.ResourceWithIdentity = &StudiosResource{}
+	_ resource.ResourceWithIdentity = &TeamsResource{}
+	_ resource.ResourceWithIdentity = &TokensResource{}
+	_ resource.ResourceWithIdentity = &TowerAgentCredentialResource{}
+	_ resource.ResourceWithIdentity = &WorkflowsResource{}
+	_ resource.ResourceWithIdentity = &WorkspaceResource{}
+)
+
+// Resource attributes holding the identity of each resource.
+var (
+	actionIdentity            = common.WorkspaceIdentity("action_id")
+	computeEnvIdentity        = common.WorkspaceIdentity("compute_env_id")
+	credentialIdentity        = common.WorkspaceIdentity("credentials_id")
+	customRoleIdentity        = common.OrgIdentity("name")
+	dataLinkIdentity          = common.WorkspaceIdentity("data_link_id")
+	datasetIdentity           = common.WorkspaceIdentity("id")
+	labelIdentity             = common.WorkspaceIdentity("label_id")
+	orgIdentity               = common.IdentityAttributes{"id": "org_id"}
+	pipelineIdentity          = common.WorkspaceIdentity("pipeline_id")
+	pipelineSecretIdentity    = common.WorkspaceIdentity("secret_id")
+	primaryComputeEnvIdentity = common.IdentityAttributes{"workspace_id": "workspace_id"}
+	studioIdentity            = common.WorkspaceIdentity("session_id")
+	teamIdentity              = common.OrgIdentity("team_id")
+	tokenIdentity             = common.IdentityAttributes{"id": "id"}
+	workflowIdentity          = common.WorkspaceIdentity("workflow_id")
+	workspaceIdentity         = common.OrgIdentity("id")
+)
+
+func computeEnvIdentitySchema() identityschema.Schema {
+	return common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Compute environment string identifier.`,
+	})
+}
+
+func credentialIdentitySchema() identityschema.Schema {
+	return common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Credentials string identifier.`,
+	})
+}
+
+func (r *ActionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Action string identifier.`,
+	})
+}
+
+func (r *AWSBatchCEResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *AwsCloudCEResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *AWSComputeEnvResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *AWSCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *AzureBatchCEResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *AzureCloudCEResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *AzureCloudCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *AzureCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *AzureEntraCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *BitbucketCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *CodecommitCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *ComputeEnvResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *ContainerRegistryCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *CredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *CustomRoleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.OrgIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Custom role name.`,
+	})
+}
+
+func (r *DataLinkResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Data-link string identifier.`,
+	})
+}
+
+func (r *DatasetsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Dataset string identifier.`,
+	})
+}
+
+func (r *GCPBatchCEResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *GCPCloudCEResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *GiteaCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *GithubAppCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *GithubCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *GitlabCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *GoogleCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *KubernetesCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *LabelsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.Int64Attribute{
+		RequiredForImport: true,
+		Description:       `Label numeric identifier.`,
+	})
+}
+
+func (r *ManagedComputeCEResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *OrgsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Organization numeric identifier.`,
+			},
+		},
+	}
+}
+
+func (r *PipelineResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.Int64Attribute{
+		RequiredForImport: true,
+		Description:       `Pipeline numeric identifier.`,
+	})
+}
+
+func (r *PipelineSecretResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.Int64Attribute{
+		RequiredForImport: true,
+		Description:       `Secret numeric identifier.`,
+	})
+}
+
+func (r *PrimaryComputeEnvResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.Int64Attribute{
+				OptionalForImport: true,
+				Description:       `Workspace numeric identifier. Defaults to the provider default_workspace on import.`,
+			},
+		},
+	}
+}
+
+func (r *SlurmCEResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = computeEnvIdentitySchema()
+}
+
+func (r *SSHCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *StudiosResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Studio session identifier.`,
+	})
+}
+
+func (r *TeamsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.OrgIdentitySchema(identityschema.Int64Attribute{
+		RequiredForImport: true,
+		Description:       `Team numeric identifier.`,
+	})
+}
+
+func (r *TokensResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Token numeric identifier.`,
+			},
+		},
+	}
+}
+
+func (r *TowerAgentCredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentitySchema()
+}
+
+func (r *WorkflowsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Workflow string identifier.`,
+	})
+}
+
+func (r *WorkspaceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.OrgIdentitySchema(identityschema.Int64Attribute{
+		RequiredForImport: true,
+		Description:       `Workspace numeric identifier.`,
+	})
+}
@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// Lookups for the generated resources whose API has no describe operation,
+// or whose generated Read does not refresh from it: datasets, labels and
+// tokens. Read finds the object by ID through these, and ImportState by
+// the name a user knows it by. The operations below replace the generated
+// ones through the resource extensions (resource_extensions.go), which also
+// keep the identity in sync.
+
+// findDataset returns the dataset of the workspace whose ID or name is
+// reference, or nil if there is none.
+func findDataset(ctx context.Context, client *sdk.Seqera, workspaceID int64, reference string) (*shared.DatasetDto, error) {
+	return common.PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]shared.DatasetDto, int64, error) {
+			res, err := client.Datasets.ListDatasetsV2(ctx, operations.ListDatasetsV2Request{
+				WorkspaceID: &workspaceID,
+				Max:         &max,
+				Offset:      &offset,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing datasets: %w", err)
+			}
+			if res.StatusCode != http.StatusOK || res.ListDatasetsResponse == nil {
+				return nil, 0, unexpectedResponseErr(ctx, "listing datasets", res.RawResponse)
+			}
+			var totalSize int64
+			if res.ListDatasetsResponse.TotalSize != nil {
+				totalSize = *res.ListDatasetsResponse.TotalSize
+			}
+			return res.ListDatasetsResponse.Datasets, totalSize, nil
+		},
+		func(dataset *shared.DatasetDto) bool {
+			return (dataset.ID != nil && *dataset.ID == reference) || (dataset.Name != nil && *dataset.Name == reference)
+		},
+	)
+}
+
+// findLabel returns the first label of the workspace accepted by match, or
+// nil if there is none. A non-empty search narrows the listing by name.
+func findLabel(ctx context.Context, client *sdk.Seqera, workspaceID int64, search string, match func(*shared.LabelDbDto) bool) (*shared.LabelDbDto, error) {
+	return common.PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]shared.LabelDbDto, int64, error) {
+			request := operations.ListLabelsRequest{
+				WorkspaceID: &workspaceID,
+				Max:         &max,
+				Offset:      &offset,
+			}
+			if search != "" {
+				request.Search = &search
+			}
+			res, err := client.Labels.ListLabels(ctx, request)
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing labels: %w", err)
+			}
+			if res.StatusCode != http.StatusOK || res.RawResponse == nil {
+				return nil, 0, unexpectedResponseErr(ctx, "listing labels", res.RawResponse)
+			}
+			return common.DecodeLabelList(res.RawResponse.Body)
+		},
+		match,
+	)
+}
+
+// findToken returns the first access token of the user accepted by match, or
+// nil if there is none.
+func findToken(ctx context.Context, client *sdk.Seqera, match func(*shared.AccessToken) bool) (*shared.AccessToken, error) {
+	res, err := client.Tokens.TokenList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing access tokens: %w", err)
+	}
+	if res.StatusCode != http.StatusOK || res.ListAccessTokensResponse == nil {
+		return nil, unexpectedResponseErr(ctx, "listing access tokens", res.RawResponse)
+	}
+	for i := range res.ListAccessTokensResponse.Tokens {
+		if token := &res.ListAccessTokensResponse.Tokens[i]; match(token) {
+			return token, nil
+		}
+	}
+	return nil, nil
+}
+
+// refreshFromLabel updates the model from a listed label, which carries the
+// label ID as `id`.
+func (r *LabelsResourceModel) refreshFromLabel(label *shared.LabelDbDto) {
+	r.ID = types.Int64PointerValue(label.ID)
+	r.LabelID = types.Int64PointerValue(label.ID)
+	r.IsDefault = types.BoolPointerValue(label.IsDefault)
+	r.Name = types.StringPointerValue(label.Name)
+	r.Resource = types.BoolPointerValue(label.Resource)
+	r.Value = types.StringPointerValue(label.Value)
+}
+
+// createToken creates an access token like the generated Create, then
+// refreshes it from the token listing, which carries the dates and last use
+// the create response leaves out.
+func createToken(ctx context.Context, client *sdk.Seqera, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TokensResourceModel
+	var plan types.Object
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.As(ctx, &data, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, requestDiags := data.ToSharedCreateAccessTokenRequest(ctx)
+	resp.Diagnostics.Append(requestDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := client.Tokens.CreateToken(ctx, *request)
+	if err != nil {
+		addInvokeError(ctx, &resp.Diagnostics, req.Plan.Raw.Type(), err)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return
+	}
+	if res.StatusCode == http.StatusConflict {
+		resp.Diagnostics.AddError(
+			"Resource Already Exists",
+			"When creating this resource, the API indicated that this resource already exists. You can bring the existing resource under management using Terraform import functionality or retry with a unique configuration.",
+		)
+		return
+	}
+	if res.StatusCode != http.StatusOK {
+		addUnexpectedResponse(ctx, &resp.Diagnostics, req.Plan.Raw.Type(), res.RawResponse)
+		return
+	}
+	if !(res.CreateAccessTokenResponse != nil) {
+		resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return
+	}
+	resp.Diagnostics.Append(data.RefreshFromSharedCreateAccessTokenResponse(ctx, res.CreateAccessTokenResponse)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tokenID := data.ID.ValueInt64()
+	token, err := findToken(ctx, client, func(token *shared.AccessToken) bool {
+		return token.ID != nil && *token.ID == tokenID
+	})
+	if err != nil {
+		addInvokeError(ctx, &resp.Diagnostics, req.Plan.Raw.Type(), err)
+		return
+	}
+	if token != nil {
+		resp.Diagnostics.Append(data.RefreshFromSharedAccessToken(ctx, token)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readToken refreshes an access token from the token listing, as there is
+// no describe operation.
+func readToken(ctx context.Context, client *sdk.Seqera, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TokensResourceModel
+	var item types.Object
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &item)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(item.As(ctx, &data, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenID := data.ID.ValueInt64()
+	token, err := findToken(ctx, client, func(token *shared.AccessToken) bool {
+		return token.ID != nil && *token.ID == tokenID
+	})
+	if err != nil {
+		addInvokeError(ctx, &resp.Diagnostics, req.State.Raw.Type(), err)
+		return
+	}
+	if token == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(data.RefreshFromSharedAccessToken(ctx, token)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func importToken(ctx context.Context, client *sdk.Seqera, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: the token ID or name. The access_key is only returned
+	// on creation, so it stays null after import.
+	attributes, err := common.ParseImportID("seqera_tokens", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	token, err := findToken(ctx, client, func(token *shared.AccessToken) bool {
+		if id, ok := attributes["id"]; ok {
+			return token.ID != nil && strconv.FormatInt(*token.ID, 10) == id
+		}
+		return token.Name == attributes["name"]
+	})
+	if err != nil {
+		addInvokeError(ctx, &resp.Diagnostics, resp.State.Raw.Type(), err)
+		return
+	}
+	if token == nil {
+		resp.Diagnostics.AddError("Access Token Not Found", fmt.Sprintf("No access token with ID or name %s exists", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *token.ID)...)
+}
+
+// readLabel refreshes a label from the label listing, as there is no
+// describe operation.
+func readLabel(ctx context.Context, client *sdk.Seqera, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *LabelsResourceModel
+	var item types.Object
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &item)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(item.As(ctx, &data, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labelID := data.LabelID.ValueInt64()
+	label, err := findLabel(ctx, client, data.WorkspaceID.ValueInt64(), "", func(label *shared.LabelDbDto) bool {
+		return label.ID != nil && *label.ID == labelID
+	})
+	if err != nil {
+		addInvokeError(ctx, &resp.Diagnostics, req.State.Raw.Type(), err)
+		return
+	}
+	if label == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.refreshFromLabel(label)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func importLabel(ctx context.Context, client *sdk.Seqera, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import formats: workspace_id/name, or workspace_id/name=value for a
+	// resource label.
+	attributes, err := common.ParseImportID("seqera_labels", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	// ParseImportID has checked that workspace_id is a number.
+	workspaceID, _ := strconv.ParseInt(attributes["workspace_id"], 10, 64)
+	name := attributes["name"]
+	reference := name
+	if value, ok := attributes["value"]; ok {
+		reference += "=" + value
+	}
+	label, err := findLabel(ctx, client, workspaceID, name, func(label *shared.LabelDbDto) bool {
+		return label.ID != nil && label.Name != nil && labelDisplayName(label) == reference
+	})
+	if err != nil {
+		addInvokeError(ctx, &resp.Diagnostics, resp.State.Raw.Type(), err)
+		return
+	}
+	if label == nil {
+		resp.Diagnostics.AddError("Label Not Found", fmt.Sprintf("No label %s exists in workspace %d", reference, workspaceID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("label_id"), *label.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), *label.Name)...)
+}
+
+// readDataset refreshes a dataset through the describe operation; the
+// generated Read only keeps what Create returned.
+func readDataset(ctx context.Context, client *sdk.Seqera, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DatasetsResourceModel
+	var item types.Object
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &item)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(item.As(ctx, &data, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueInt64()
+	res, err := client.Datasets.DescribeDatasetV2(ctx, operations.DescribeDatasetV2Request{
+		WorkspaceID: &workspaceID,
+		DatasetID:   data.ID.ValueString(),
+	})
+	if err != nil {
+		addInvokeError(ctx, &resp.Diagnostics, req.State.Raw.Type(), err)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return
+	}
+	if res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.StatusCode != http.StatusOK {
+		addUnexpectedResponse(ctx, &resp.Diagnostics, req.State.Raw.Type(), res.RawResponse)
+		return
+	}
+	if !(res.DescribeDatasetResponse != nil) {
+		resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return
+	}
+	resp.Diagnostics.Append(data.RefreshFromSharedDatasetDto(ctx, res.DescribeDatasetResponse.Dataset)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.WorkspaceID.IsNull() {
+		data.WorkspaceID = types.Int64Value(workspaceID)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func importDataset(ctx context.Context, client *sdk.Seqera, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: workspace_id/dataset, the dataset given by ID or name.
+	attributes, err := common.ParseImportID("seqera_datasets", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	// ParseImportID has checked that workspace_id is a number.
+	workspaceID, _ := strconv.ParseInt(attributes["workspace_id"], 10, 64)
+	reference := attributes["dataset"]
+	dataset, err := findDataset(ctx, client, workspaceID, reference)
+	if err != nil {
+		addInvokeError(ctx, &resp.Diagnostics, resp.State.Raw.Type(), err)
+		return
+	}
+	if dataset == nil || dataset.ID == nil {
+		resp.Diagnostics.AddError("Dataset Not Found", fmt.Sprintf("No dataset %s exists in workspace %d", reference, workspaceID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *dataset.ID)...)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// configuredResource returns the resource newResource registers, extended
+// and configured against api as the provider does.
+func configuredResource(t *testing.T, newResource func() resource.Resource, api *httptest.Server) resource.ResourceWithImportState {
+	t.Helper()
+	r, diags := newConfiguredResource(context.Background(), newResource, sdk.New(sdk.WithServerURL(api.URL)))
+	if diags.HasError() {
+		t.Fatalf("configuring resource: %v", diags)
+	}
+	return r.(resource.ResourceWithImportState)
+}
+
+func importState(t *testing.T, r resource.ResourceWithImportState, id string) (tfsdk.State, bool) {
+	t.Helper()
+	ctx := context.Background()
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+	resp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schema.Schema,
+		Raw:    tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+	return resp.State, !resp.Diagnostics.HasError()
+}
+
+func TestTokensImportState(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"tokens": []map[string]any{
+			{"id": 11, "name": "ci"},
+			{"id": 12, "name": "nightly"},
+		}})
+	}))
+	defer api.Close()
+	tokens := configuredResource(t, NewTokensResource, api)
+
+	for id, want := range map[string]int64{"nightly": 12, "11": 11, "missing": 0} {
+		state, ok := importState(t, tokens, id)
+		if ok != (want != 0) {
+			t.Errorf("import %q: expected success %v", id, want != 0)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var got int64
+		state.GetAttribute(context.Background(), path.Root("id"), &got)
+		if got != want {
+			t.Errorf("import %q: expected token %d, got %d", id, want, got)
+		}
+	}
+}
+
+func TestLabelsImportState(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"totalSize": 3, "labels": []map[string]any{
+			{"id": 1, "name": "team"},
+			{"id": 2, "name": "team", "value": "genomics", "resource": true},
+			{"id": 3, "name": "team", "value": "imaging", "resource": true},
+		}})
+	}))
+	defer api.Close()
+	labels := configuredResource(t, NewLabelsResource, api)
+
+	for id, want := range map[string]int64{"7/team": 1, "7/team=imaging": 3, "7/team=other": 0, "team": 0} {
+		state, ok := importState(t, labels, id)
+		if ok != (want != 0) {
+			t.Errorf("import %q: expected success %v", id, want != 0)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var labelID, workspaceID int64
+		state.GetAttribute(context.Background(), path.Root("label_id"), &labelID)
+		state.GetAttribute(context.Background(), path.Root("workspace_id"), &workspaceID)
+		if labelID != want || workspaceID != 7 {
+			t.Errorf("import %q: expected label %d in workspace 7, got %d in %d", id, want, labelID, workspaceID)
+		}
+	}
+}
+
+func TestTokensImportStateUnexpectedResponse(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(w).Encode(map[string]any{"message": "database unavailable"})
+	}))
+	defer api.Close()
+	tokens := configuredResource(t, NewTokensResource, api)
+
+	ctx := context.Background()
+	var schema resource.SchemaResponse
+	tokens.Schema(ctx, resource.SchemaRequest{}, &schema)
+	resp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schema.Schema,
+		Raw:    tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil),
+	}}
+	tokens.ImportState(ctx, resource.ImportStateRequest{ID: "ci"}, resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("expected one error, got %v", resp.Diagnostics)
+	}
+	want := "Status 500 while calling GET /tokens: database unavailable"
+	if errs[0].Summary() != "Unexpected API response" || errs[0].Detail() != want {
+		t.Errorf("expected %q, got %q: %q", want, errs[0].Summary(), errs[0].Detail())
+	}
+}
+
+// TestImportIDFormats checks that parse_import_id and format_import_id know
+// the import ID of every importable resource, and of no other.
+func TestImportIDFormats(t *testing.T) {
+	ctx := context.Background()
+	// The generated ImportState of these only reports that import is not
+	// implemented.
+	notImportable := map[string]bool{"seqera_primary_compute_env": true}
+	known := map[string]bool{}
+	for _, name := range common.ImportIDResourceTypes() {
+		known[name] = true
+	}
+
+	for _, newResource := range NewExtended("test")().Resources(ctx) {
+		r := newResource()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "seqera"}, &metadata)
+		_, importable := r.(resource.ResourceWithImportState)
+		importable = importable && !notImportable[metadata.TypeName]
+		if importable != known[metadata.TypeName] {
+			t.Errorf("%s: resource imports %v, import ID format registered %v", metadata.TypeName, importable, known[metadata.TypeName])
+		}
+		delete(known, metadata.TypeName)
+	}
+	for name := range known {
+		t.Errorf("%s: import ID format registered for an unknown resource", name)
+	}
+}
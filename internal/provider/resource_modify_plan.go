@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// ModifyPlan for the generated workspace-scoped resources. Speakeasy does not
+// generate plan modifiers at the resource level, so they live here rather than
+// in the *_resource.go files. Each fills workspace_id from the provider
+// default_workspace when the configuration omits it; the last argument to
+// common.ApplyDefaultWorkspace mirrors whether the generated schema replaces
+// the resource when workspace_id changes.
+//
+// Resources needing a minimum platform release or a feature flag also check
+// it here against the service info detected at Configure time, and resources
+// with a Validate*Name operation check that a new name is free
+// (resource_name_check.go).
+
+var (
+	_ resource.ResourceWithModifyPlan = &ActionResource{}
+	_ resource.ResourceWithModifyPlan = &AWSBatchCEResource{}
+	_ resource.ResourceWithModifyPlan = &AwsCloudCEResource{}
+	_ resource.ResourceWithModifyPlan = &AWSComputeEnvResource{}
+	_ resource.ResourceWithModifyPlan = &AWSCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &AzureBatchCEResource{}
+	_ resource.ResourceWithModifyPlan = &AzureCloudCEResource{}
+	_ resource.ResourceWithModifyPlan = &AzureCloudCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &AzureCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &AzureEntraCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &BitbucketCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &CodecommitCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &CustomRoleResource{}
+	_ resource.ResourceWithModifyPlan = &ComputeEnvResource{}
+	_ resource.ResourceWithModifyPlan = &ContainerRegistryCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &CredentialResource{}
+	_ resource.ResourceWithModifyPlan = &DataLinkResource{}
+	_ resource.ResourceWithModifyPlan = &DatasetsResource{}
+	_ resource.ResourceWithModifyPlan = &GCPBatchCEResource{}
+	_ resource.ResourceWithModifyPlan = &GCPCloudCEResource{}
+	_ resource.ResourceWithModifyPlan = &GiteaCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &GithubAppCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &GithubCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &GitlabCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &GoogleCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &KubernetesCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &LabelsResource{}
+	_ resource.ResourceWithModifyPlan = &ManagedComputeCEResource{}
+	_ resource.ResourceWithModifyPlan = &PipelineResource{}
+	_ resource.ResourceWithModifyPlan = &PipelineSecretResource{}
+	_ resource.ResourceWithModifyPlan = &PrimaryComputeEnvResource{}
+	_ resource.ResourceWithModifyPlan = &SlurmCEResource{}
+	_ resource.ResourceWithModifyPlan = &SSHCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &StudiosResource{}
+	_ resource.ResourceWithModifyPlan = &TeamsResource{}
+	_ resource.ResourceWithModifyPlan = &TowerAgentCredentialResource{}
+	_ resource.ResourceWithModifyPlan = &WorkflowsResource{}
+	_ resource.ResourceWithModifyPlan = &WorkspaceResource{}
+)
+
+func (r *ActionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, false)
+	common.CheckNameAvailable(ctx, r.client, req, resp, actionNameCheck)
+}
+
+func (r *AWSBatchCEResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+}
+
+func (r *AwsCloudCEResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+}
+
+func (r *AWSComputeEnvResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+}
+
+func (r *AWSCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *AzureBatchCEResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	requireAzureBatchCleanupFlags(ctx, r.client, req, path.Root("config"), resp)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+}
+
+func (r *AzureCloudCEResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+}
+
+func (r *AzureCloudCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *AzureCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *AzureEntraCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *BitbucketCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *CodecommitCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+// Custom roles were introduced in Seqera Enterprise v25.3.
+func (r *CustomRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		common.RequireServerVersion(r.client, "25.3", "seqera_custom_role", path.Empty(), &resp.Diagnostics)
+	}
+	common.CheckNameAvailable(ctx, r.client, req, resp, customRoleNameCheck)
+}
+
+func (r *ComputeEnvResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, false)
+	requireAzureBatchCleanupFlags(ctx, r.client, req, path.Root("compute_env").AtName("config").AtName("azure_batch"), resp)
+	check := computeEnvNameCheck
+	check.Name = path.Root("compute_env").AtName("name")
+	common.CheckNameAvailable(ctx, r.client, req, resp, check)
+}
+
+func (r *ContainerRegistryCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *CredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, false)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *DataLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, false)
+}
+
+func (r *DatasetsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+}
+
+func (r *GCPBatchCEResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+}
+
+func (r *GCPCloudCEResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+}
+
+func (r *GiteaCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *GithubAppCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *GithubCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *GitlabCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *GoogleCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *KubernetesCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *LabelsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, false)
+}
+
+func (r *ManagedComputeCEResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+	if !req.Plan.Raw.IsNull() {
+		common.RequireServerFeature(r.client, "seqeraComputeEnabled", "seqera_managed_compute_ce", &resp.Diagnostics)
+	}
+}
+
+func (r *PipelineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, false)
+	common.CheckNameAvailable(ctx, r.client, req, resp, pipelineNameCheck)
+}
+
+func (r *PipelineSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, false)
+	common.CheckNameAvailable(ctx, r.client, req, resp, pipelineSecretNameCheck)
+}
+
+func (r *PrimaryComputeEnvResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+}
+
+func (r *SlurmCEResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, computeEnvNameCheck)
+}
+
+func (r *SSHCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *StudiosResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, studioNameCheck)
+}
+
+func (r *TeamsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.CheckNameAvailable(ctx, r.client, req, resp, teamNameCheck)
+}
+
+func (r *TowerAgentCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	common.CheckNameAvailable(ctx, r.client, req, resp, credentialsNameCheck)
+}
+
+func (r *WorkflowsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+}
+
+func (r *WorkspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.CheckNameAvailable(ctx, r.client, req, resp, workspaceNameCheck)
+}
+
+// requireAzureBatchCleanupFlags rejects the boolean Azure Batch cleanup
+// settings under config on platforms older than Seqera Enterprise v26.1,
+// which only accept delete_jobs_on_completion.
+func requireAzureBatchCleanupFlags(ctx context.Context, client *sdk.Seqera, req resource.ModifyPlanRequest, config path.Path, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	for _, name := range []string{"delete_jobs_on_completion_enabled", "delete_pools_on_completion", "delete_tasks_on_completion"} {
+		common.RequireServerVersionIfSet(ctx, client, req.Config, config.AtName(name), "26.1", &resp.Diagnostics)
+	}
+}
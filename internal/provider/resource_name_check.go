@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+// Name availability checks run from ModifyPlan (resource_modify_plan.go) by
+// the resources whose API has a Validate*Name operation, so a name already
+// taken in the workspace or organization fails the plan.
+
+var (
+	actionNameCheck = common.NameCheck{
+		Kind:     "pipeline action",
+		Name:     path.Root("name"),
+		Scope:    path.Root("workspace_id"),
+		Validate: validateActionName,
+	}
+	computeEnvNameCheck = common.NameCheck{
+		Kind:      "compute environment",
+		Name:      path.Root("name"),
+		Scope:     path.Root("workspace_id"),
+		Adoptable: true,
+		Validate:  validateComputeEnvName,
+	}
+	credentialsNameCheck = common.NameCheck{
+		Kind:      "credential",
+		Name:      path.Root("name"),
+		Scope:     path.Root("workspace_id"),
+		Adoptable: true,
+		Validate:  validateCredentialsName,
+	}
+	customRoleNameCheck = common.NameCheck{
+		Kind:     "role",
+		Name:     path.Root("name"),
+		Scope:    path.Root("org_id"),
+		Validate: validateRoleName,
+	}
+	pipelineNameCheck = common.NameCheck{
+		Kind:      "pipeline",
+		Name:      path.Root("name"),
+		Scope:     path.Root("workspace_id"),
+		Adoptable: true,
+		Validate:  validatePipelineName,
+	}
+	pipelineSecretNameCheck = common.NameCheck{
+		Kind:      "pipeline secret",
+		Name:      path.Root("name"),
+		Scope:     path.Root("workspace_id"),
+		Adoptable: true,
+		Validate:  validatePipelineSecretName,
+	}
+	studioNameCheck = common.NameCheck{
+		Kind:     "studio",
+		Name:     path.Root("name"),
+		Scope:    path.Root("workspace_id"),
+		Validate: validateDataStudioName,
+	}
+	teamNameCheck = common.NameCheck{
+		Kind:      "team",
+		Name:      path.Root("name"),
+		Scope:     path.Root("org_id"),
+		Adoptable: true,
+		Validate:  validateTeamName,
+	}
+	workspaceNameCheck = common.NameCheck{
+		Kind:      "workspace",
+		Name:      path.Root("name"),
+		Scope:     path.Root("org_id"),
+		Adoptable: true,
+		Validate:  validateWorkspaceName,
+	}
+)
+
+// rejectionMessage returns the server message of a rejected name, if any.
+func rejectionMessage(errorResponse *shared.ErrorResponse) string {
+	if errorResponse == nil {
+		return ""
+	}
+	return errorResponse.Message
+}
+
+func validateActionName(ctx context.Context, client *sdk.Seqera, workspaceID *int64, name string) (int, string, error) {
+	res, err := client.Actions.ValidateActionName(ctx, operations.ValidateActionNameRequest{WorkspaceID: workspaceID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, rejectionMessage(res.ErrorResponse), nil
+}
+
+func validateComputeEnvName(ctx context.Context, client *sdk.Seqera, workspaceID *int64, name string) (int, string, error) {
+	res, err := client.ComputeEnvs.ValidateComputeEnvName(ctx, operations.ValidateComputeEnvNameRequest{WorkspaceID: workspaceID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, rejectionMessage(res.ErrorResponse), nil
+}
+
+func validateCredentialsName(ctx context.Context, client *sdk.Seqera, workspaceID *int64, name string) (int, string, error) {
+	res, err := client.Credentials.ValidateCredentialsName(ctx, operations.ValidateCredentialsNameRequest{WorkspaceID: workspaceID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, rejectionMessage(res.ErrorResponse), nil
+}
+
+func validateDataStudioName(ctx context.Context, client *sdk.Seqera, workspaceID *int64, name string) (int, string, error) {
+	res, err := client.Studios.ValidateDataStudioName(ctx, operations.ValidateDataStudioNameRequest{WorkspaceID: workspaceID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, "", nil
+}
+
+func validatePipelineName(ctx context.Context, client *sdk.Seqera, workspaceID *int64, name string) (int, string, error) {
+	res, err := client.Pipelines.ValidatePipelineName(ctx, operations.ValidatePipelineNameRequest{WorkspaceID: workspaceID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, rejectionMessage(res.ErrorResponse), nil
+}
+
+func validatePipelineSecretName(ctx context.Context, client *sdk.Seqera, workspaceID *int64, name string) (int, string, error) {
+	res, err := client.PipelineSecrets.ValidatePipelineSecretName(ctx, operations.ValidatePipelineSecretNameRequest{WorkspaceID: workspaceID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, rejectionMessage(res.ErrorResponse), nil
+}
+
+func validateRoleName(ctx context.Context, client *sdk.Seqera, orgID *int64, name string) (int, string, error) {
+	res, err := client.Roles.ValidateRoleName(ctx, operations.ValidateRoleNameRequest{OrgID: orgID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, rejectionMessage(res.ErrorResponse), nil
+}
+
+// The team and workspace checks take the organization in the path, so there
+// is nothing to check without one.
+
+func validateTeamName(ctx context.Context, client *sdk.Seqera, orgID *int64, name string) (int, string, error) {
+	if orgID == nil {
+		return 0, "", nil
+	}
+	res, err := client.Teams.ValidateTeamName(ctx, operations.ValidateTeamNameRequest{OrgID: *orgID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, rejectionMessage(res.ErrorResponse), nil
+}
+
+func validateWorkspaceName(ctx context.Context, client *sdk.Seqera, orgID *int64, name string) (int, string, error) {
+	if orgID == nil {
+		return 0, "", nil
+	}
+	res, err := client.Workspaces.ValidateWorkspaceName(ctx, operations.ValidateWorkspaceNameRequest{OrgID: *orgID, Name: &name})
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, rejectionMessage(res.ErrorResponse), nil
+}
@@ -28,7 +28,7 @@ import (
 func TestAllRegisteredUpgradersDropUnknownAttributes(t *testing.T) {
 	ctx := context.Background()
 
-	p := &SeqeraProvider{}
+	p := &ExtendedProvider{SeqeraProvider: &SeqeraProvider{}}
 	var providerMeta provider.MetadataResponse
 	p.Metadata(ctx, provider.MetadataRequest{}, &providerMeta)
 
@@ -38,7 +38,7 @@ func TestAllRegisteredUpgradersDropUnknownAttributes(t *testing.T) {
 		res := newResource()
 
 		upgradeable, ok := res.(resource.ResourceWithUpgradeState)
-		if !ok {
+		if !ok || len(upgradeable.UpgradeState(ctx)) == 0 {
 			continue
 		}
 
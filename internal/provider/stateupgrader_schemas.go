@@ -18,7 +18,7 @@ import (
 func init() {
 	ctx := context.Background()
 
-	p := &SeqeraProvider{}
+	p := &ExtendedProvider{SeqeraProvider: &SeqeraProvider{}}
 	var providerMeta provider.MetadataResponse
 	p.Metadata(ctx, provider.MetadataRequest{}, &providerMeta)
 
@@ -26,7 +26,9 @@ func init() {
 		res := newResource()
 
 		// Only resources with state upgraders need their schema registered.
-		if _, ok := res.(resource.ResourceWithUpgradeState); !ok {
+		// The registered schema is the extended one the upgraded state must
+		// match (resource_extensions.go).
+		if upgradeable, ok := res.(resource.ResourceWithUpgradeState); !ok || len(upgradeable.UpgradeState(ctx)) == 0 {
 			continue
 		}
 
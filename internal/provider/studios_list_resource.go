@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &StudiosListResource{}
+	_ list.ListResourceWithConfigure = &StudiosListResource{}
+)
+
+func NewStudiosListResource() list.ListResource {
+	return &StudiosListResource{}
+}
+
+// StudiosListResource lists the studios of a workspace.
+type StudiosListResource struct {
+	client *sdk.Seqera
+}
+
+type StudiosListResourceModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	Name        types.String `tfsdk:"name"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func (r *StudiosListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_studios"
+}
+
+func (r *StudiosListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the studios of a workspace, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_studios`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every studio found:
+
+` + "```hcl" + `
+list "seqera_studios" "running" {
+  provider = seqera
+
+  config {
+    status = "running"
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": common.ListWorkspaceAttribute(),
+			"name":         common.ListNameAttribute(),
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list studios with this status; must be one of ["starting", "running", "stopping", "stopped", "errored", "building", "buildFailed"]`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(shared.DataStudioStatusStarting),
+						string(shared.DataStudioStatusRunning),
+						string(shared.DataStudioStatusStopping),
+						string(shared.DataStudioStatusStopped),
+						string(shared.DataStudioStatusErrored),
+						string(shared.DataStudioStatusBuilding),
+						string(shared.DataStudioStatusBuildFailed),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *StudiosListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *StudiosListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data StudiosListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	workspaceID, workspaceDiags := common.ListWorkspace(r.client, data.WorkspaceID)
+	diags.Append(workspaceDiags...)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	matchStatus := common.StringFilter(data.Status)
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		_, err := common.PaginatedSearch(ctx,
+			func(ctx context.Context, max, offset int) ([]common.StudioStatus, int64, error) {
+				res, err := r.client.Studios.ListDataStudios(ctx, operations.ListDataStudiosRequest{
+					WorkspaceID: &workspaceID,
+					Max:         &max,
+					Offset:      &offset,
+				})
+				if err != nil {
+					return nil, 0, fmt.Errorf("listing studios: %w", err)
+				}
+				if res.StatusCode != http.StatusOK || res.RawResponse == nil {
+					return nil, 0, common.UnexpectedStatusErr(ctx, "listing studios", res.RawResponse)
+				}
+				return common.DecodeStudioList(res.RawResponse.Body)
+			},
+			func(studio *common.StudioStatus) bool {
+				status := string(studio.Status)
+				if studio.SessionID == "" || !matchName(studio.Name) || !matchStatus(&status) {
+					return false
+				}
+				return !yield(common.ListItem{
+					DisplayName: studio.Name,
+					Attributes: map[string]attr.Value{
+						"workspace_id": types.Int64Value(workspaceID),
+						"session_id":   types.StringValue(studio.SessionID),
+					},
+				})
+			},
+		)
+		return err
+	}
+
+	read, diags := newConfiguredResource(ctx, NewStudiosResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, studioIdentity, walk, read.Read)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &TeamsListResource{}
+	_ list.ListResourceWithConfigure = &TeamsListResource{}
+)
+
+func NewTeamsListResource() list.ListResource {
+	return &TeamsListResource{}
+}
+
+// TeamsListResource lists the teams of an organization.
+type TeamsListResource struct {
+	client *sdk.Seqera
+}
+
+type TeamsListResourceModel struct {
+	OrgID types.Int64  `tfsdk:"org_id"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func (r *TeamsListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (r *TeamsListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the teams of an organization, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_teams`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every team found:
+
+` + "```hcl" + `
+list "seqera_teams" "all" {
+  provider = seqera
+
+  config {
+    org_id = seqera_orgs.main.org_id
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier to list from.`,
+			},
+			"name": common.ListNameAttribute(),
+		},
+	}
+}
+
+func (r *TeamsListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *TeamsListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data TeamsListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	orgID := data.OrgID.ValueInt64()
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		_, err := common.PaginatedSearch(ctx,
+			func(ctx context.Context, max, offset int) ([]shared.TeamDbDto, int64, error) {
+				res, err := r.client.Teams.ListOrganizationTeams(ctx, operations.ListOrganizationTeamsRequest{
+					OrgID:  orgID,
+					Max:    &max,
+					Offset: &offset,
+				})
+				if err != nil {
+					return nil, 0, fmt.Errorf("listing teams: %w", err)
+				}
+				if res.StatusCode != http.StatusOK || res.ListTeamResponse == nil {
+					return nil, 0, common.UnexpectedStatusErr(ctx, "listing teams", res.RawResponse)
+				}
+				var totalSize int64
+				if res.ListTeamResponse.TotalSize != nil {
+					totalSize = *res.ListTeamResponse.TotalSize
+				}
+				return res.ListTeamResponse.Teams, totalSize, nil
+			},
+			func(team *shared.TeamDbDto) bool {
+				if team.TeamID == nil || team.Name == nil || !matchName(*team.Name) {
+					return false
+				}
+				return !yield(common.ListItem{
+					DisplayName: *team.Name,
+					Attributes: map[string]attr.Value{
+						"org_id":  types.Int64Value(orgID),
+						"team_id": types.Int64Value(*team.TeamID),
+					},
+				})
+			},
+		)
+		return err
+	}
+
+	read, diags := newConfiguredResource(ctx, NewTeamsResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, teamIdentity, walk, read.Read)
+}
@@ -49,7 +49,7 @@ func (r *TokensResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *TokensResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manage API access tokens for authentication.\n\nTokens provide secure API access for programmatic access to platform\nresources and services. Use tokens for CI/CD integration, automation\nscripts, and external tools.\n\nIMPORTANT: The token value (access_key) is only available immediately\nafter creation. Store it securely as it cannot be retrieved later.\n",
+		MarkdownDescription: "Manage API access tokens for authentication.\n\nTokens provide secure API access for programmatic access to platform\nresources and services. Use tokens for CI/CD integration, automation\nscripts, and external tools.\n\nIMPORTANT: The token value (access_key) is only available immediately\nafter creation. Store it securely as it cannot be retrieved later.\nAn imported token keeps a null access_key.\n",
 		Attributes: map[string]schema.Attribute{
 			"access_key": schema.StringAttribute{
 				Computed:  true,
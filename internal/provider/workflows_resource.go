@@ -85,7 +85,7 @@ func (r *WorkflowsResource) Metadata(ctx context.Context, req resource.MetadataR
 
 func (r *WorkflowsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Launch and track an individual workflow run on the Seqera Platform\n— equivalent to a Run on the Runs page.\n\nEach `seqera_workflows` resource maps to one execution of a\nNextflow pipeline against a specific compute environment. Use it\nwhen Terraform itself should trigger runs (one-off validations,\nCI fan-outs, post-CE-change smoke tests).\n\nReach for `seqera_pipeline` instead when you want a persistent,\nre-launchable definition on the Launchpad rather than a single\nrun.\n",
+		MarkdownDescription: "Launch and track an individual workflow run on the Seqera Platform\n— equivalent to a Run on the Runs page.\n\nEach `seqera_workflows` resource maps to one execution of a\nNextflow pipeline against a specific compute environment. Use it\nwhen Terraform itself should trigger runs (one-off validations,\nCI fan-outs, post-CE-change smoke tests).\n\nReach for `seqera_pipeline` instead when you want a persistent,\nre-launchable definition on the Launchpad rather than a single\nrun, and for the `seqera_launch_pipeline` action when a run\ntriggered by changes to other resources should not be kept in\nstate.\n",
 		Attributes: map[string]schema.Attribute{
 			"compute_env_id": schema.StringAttribute{
 				Optional: true,
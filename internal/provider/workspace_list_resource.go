@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ list.ListResource              = &WorkspaceListResource{}
+	_ list.ListResourceWithConfigure = &WorkspaceListResource{}
+)
+
+func NewWorkspaceListResource() list.ListResource {
+	return &WorkspaceListResource{}
+}
+
+// WorkspaceListResource lists the workspaces of an organization.
+type WorkspaceListResource struct {
+	client *sdk.Seqera
+}
+
+type WorkspaceListResourceModel struct {
+	OrgID types.Int64  `tfsdk:"org_id"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func (r *WorkspaceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+func (r *WorkspaceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the workspaces of an organization, for ` + "`terraform query`" + `.
+
+Each result carries the identity of a ` + "`seqera_workspace`" + `, so ` + "`terraform query -generate-config-out`" + ` writes an ` + "`import`" + ` block and configuration for every workspace found:
+
+` + "```hcl" + `
+list "seqera_workspace" "all" {
+  provider = seqera
+
+  config {
+    org_id = seqera_orgs.main.org_id
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier to list from.`,
+			},
+			"name": common.ListNameAttribute(),
+		},
+	}
+}
+
+func (r *WorkspaceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *WorkspaceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data WorkspaceListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	matchName, nameDiags := common.NameFilter(data.Name)
+	diags.Append(nameDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	orgID := data.OrgID.ValueInt64()
+
+	walk := func(ctx context.Context, yield func(common.ListItem) bool) error {
+		res, err := r.client.Workspaces.ListWorkspaces(ctx, operations.ListWorkspacesRequest{
+			OrgID: orgID,
+		})
+		if err != nil {
+			return fmt.Errorf("listing workspaces: %w", err)
+		}
+		if res.StatusCode != http.StatusOK || res.ListWorkspacesResponse == nil {
+			return common.UnexpectedStatusErr(ctx, "listing workspaces", res.RawResponse)
+		}
+		for _, workspace := range res.ListWorkspacesResponse.Workspaces {
+			if workspace.ID == nil || workspace.Name == nil || !matchName(*workspace.Name) {
+				continue
+			}
+			if !yield(common.ListItem{
+				DisplayName: *workspace.Name,
+				Attributes: map[string]attr.Value{
+					"org_id": types.Int64Value(orgID),
+					"id":     types.Int64Value(*workspace.ID),
+				},
+			}) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	read, diags := newConfiguredResource(ctx, NewWorkspaceResource, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	common.StreamListResults(ctx, req, stream, workspaceIdentity, walk, read.Read)
+}
@@ -4,14 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/internal/utils"
 	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/polling"
 )
 
 /*
@@ -25,7 +26,8 @@ For Compute Environment Creation:
   - We poll the describe endpoint until the status field becomes "AVAILABLE"
   - If status becomes "ERRORED" or "INVALID", the operation fails
   - Polling configuration: 10-second intervals with 5-minute overall timeout (1s retry for transient errors)
-  - Total timeout: 5 minutes
+  - Total timeout: 5 minutes, unless the resource's `timeouts` block attaches a
+    different deadline and interval to the request context (see package polling)
 
 For Compute Environment Deletion:
   - The API responds with a 204 status code acknowledging the deletion request
@@ -43,13 +45,13 @@ caused by the API's asynchronous behavior.
 const (
 	// ComputeEnvInitialWait defines initial wait before first poll (gives API time to initialize)
 	ComputeEnvInitialWait = 2 * time.Second
-	// ComputeEnvPollInterval defines time between normal polling attempts
+	// ComputeEnvPollInterval defines the default time between normal polling attempts
 	ComputeEnvPollInterval = 10 * time.Second
 	// ComputeEnvRetryInterval defines time between retries after transient errors
 	ComputeEnvRetryInterval = 1 * time.Second
 	// ComputeEnvHTTPTimeout defines timeout for individual HTTP requests
 	ComputeEnvHTTPTimeout = 30 * time.Second
-	// ComputeEnvOverallTimeout defines maximum total time for polling operations
+	// ComputeEnvOverallTimeout defines the default maximum total time for polling operations
 	ComputeEnvOverallTimeout = 5 * time.Minute
 )
 
@@ -128,6 +130,8 @@ func (h *ComputeEnvStatusHook) AfterSuccess(hookCtx AfterSuccessContext, res *ht
 	// Poll for status
 	finalStatus, err := h.pollComputeEnvStatus(
 		hookCtx.Context,
+		hookCtx.SDKConfiguration.Client,
+		hookCtx.SecuritySource,
 		hookCtx.BaseURL,
 		computeEnvID,
 		workspaceID,
@@ -201,6 +205,8 @@ func extractComputeEnvIDFromPath(req *http.Request) (string, error) {
 // pollComputeEnvStatus polls the compute environment status until it's AVAILABLE (for create) or deleted flag is true (for delete)
 func (h *ComputeEnvStatusHook) pollComputeEnvStatus(
 	ctx context.Context,
+	client HTTPClient,
+	securitySource func(context.Context) (interface{}, error),
 	baseURL string,
 	computeEnvID string,
 	workspaceID string,
@@ -211,8 +217,19 @@ func (h *ComputeEnvStatusHook) pollComputeEnvStatus(
 		return "", fmt.Errorf("authorization header is empty")
 	}
 
+	overallTimeout := ComputeEnvOverallTimeout
+	pollInterval := ComputeEnvPollInterval
+	if opts, ok := polling.FromContext(ctx); ok {
+		if opts.Timeout > 0 {
+			overallTimeout = opts.Timeout
+		}
+		if opts.Interval > 0 {
+			pollInterval = opts.Interval
+		}
+	}
+
 	// Enforce overall timeout
-	ctx, cancel := context.WithTimeout(ctx, ComputeEnvOverallTimeout)
+	ctx, cancel := context.WithTimeout(ctx, overallTimeout)
 	defer cancel()
 
 	// Wait a bit before starting to poll - gives the API time to initialize
@@ -222,9 +239,10 @@ func (h *ComputeEnvStatusHook) pollComputeEnvStatus(
 		return "", fmt.Errorf("polling cancelled during initial wait: %w", ctx.Err())
 	}
 
-	// Create HTTP client once for all polling attempts
-	client := &http.Client{
-		Timeout: ComputeEnvHTTPTimeout,
+	// Poll through the SDK's client so the provider's TLS, proxy and logging
+	// configuration also applies to status checks.
+	if client == nil {
+		client = &http.Client{}
 	}
 
 	describeURL := fmt.Sprintf("%s/compute-envs/%s?workspaceId=%s",
@@ -243,18 +261,33 @@ func (h *ComputeEnvStatusHook) pollComputeEnvStatus(
 		default:
 		}
 
-		req, err := http.NewRequestWithContext(ctx, "GET", describeURL, nil)
+		// Bound each describe call individually; the SDK client has no
+		// timeout of its own.
+		reqCtx, reqCancel := context.WithTimeout(ctx, ComputeEnvHTTPTimeout)
+
+		req, err := http.NewRequestWithContext(reqCtx, "GET", describeURL, nil)
 		if err != nil {
+			reqCancel()
 			return "", fmt.Errorf("failed to create describe request: %w", err)
 		}
 
+		// Re-resolve credentials on every describe call: polling can outlive
+		// a short-lived token supplied through a refreshing security source.
 		req.Header.Set("Authorization", authHeader)
+		if securitySource != nil {
+			if err := utils.PopulateSecurity(reqCtx, req, securitySource); err != nil {
+				reqCancel()
+				return "", fmt.Errorf("failed to resolve credentials for describe request: %w", err)
+			}
+		}
 		req.Header.Set("Accept", "application/json")
 
 		resp, err := client.Do(req)
 		if err != nil {
-			// Retry all network errors except context cancellation
-			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			reqCancel()
+			// Retry all network errors, including a timed-out describe call,
+			// unless the overall polling context is done
+			if ctx.Err() != nil {
 				return "", fmt.Errorf("request cancelled: %w", err)
 			}
 			// Network error - retry quickly
@@ -269,6 +302,7 @@ func (h *ComputeEnvStatusHook) pollComputeEnvStatus(
 
 		bodyBytes, readErr := io.ReadAll(resp.Body)
 		closeErr := resp.Body.Close()
+		reqCancel()
 		if readErr != nil {
 			return "", fmt.Errorf("failed to read describe response: %w", readErr)
 		}
@@ -330,7 +364,7 @@ func (h *ComputeEnvStatusHook) pollComputeEnvStatus(
 
 		// Wait before next poll
 		select {
-		case <-time.After(ComputeEnvPollInterval):
+		case <-time.After(pollInterval):
 		case <-ctx.Done():
 			return "", fmt.Errorf("timeout waiting for compute environment (last status: %s): %w", lastStatus, ctx.Err())
 		}
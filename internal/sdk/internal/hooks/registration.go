@@ -23,6 +23,13 @@ func initHooks(h *Hooks) {
 	tokenListErrorHook := &TokenListErrorHook{}
 	h.registerAfterSuccessHook(tokenListErrorHook)
 
+	// Register the response recorder last, so it sees the responses as
+	// rewritten by the hooks above
+	responseRecorderHook := &ResponseRecorderHook{}
+	h.registerBeforeRequestHook(responseRecorderHook)
+	h.registerAfterSuccessHook(responseRecorderHook)
+	h.registerAfterErrorHook(responseRecorderHook)
+
 	// exampleHook := &ExampleHook{}
 
 	// h.registerSDKInitHook(exampleHook)
@@ -0,0 +1,32 @@
+package hooks
+
+import (
+	"net/http"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/responses"
+)
+
+// ResponseRecorderHook records the response of every call in the recorder the
+// provider attached to the request context (see package responses), so that
+// a failed call of a generated resource can be reported from its status code
+// and body. It must be registered after the hooks that rewrite responses.
+type ResponseRecorderHook struct{}
+
+// BeforeRequest clears the recorded response, so that a call failing before
+// it reaches AfterSuccess does not leave the previous call's response behind.
+func (h *ResponseRecorderHook) BeforeRequest(hookCtx BeforeRequestContext, req *http.Request) (*http.Request, error) {
+	responses.Record(hookCtx.Context, nil)
+	return req, nil
+}
+
+// AfterSuccess implements the afterSuccessHook interface
+func (h *ResponseRecorderHook) AfterSuccess(hookCtx AfterSuccessContext, res *http.Response) (*http.Response, error) {
+	responses.Record(hookCtx.Context, res)
+	return res, nil
+}
+
+// AfterError implements the afterErrorHook interface
+func (h *ResponseRecorderHook) AfterError(hookCtx AfterErrorContext, res *http.Response, err error) (*http.Response, error) {
+	responses.Record(hookCtx.Context, res)
+	return res, err
+}
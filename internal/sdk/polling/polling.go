@@ -0,0 +1,29 @@
+// Package polling carries per-operation polling settings from the provider to
+// the SDK hooks. Hooks only see the request context, so resources that expose
+// Terraform `timeouts` attach their deadline here before calling the SDK.
+package polling
+
+import (
+	"context"
+	"time"
+)
+
+// Options controls how long a hook waits for an asynchronous operation and
+// how often it checks on it. Zero values mean "use the hook's default".
+type Options struct {
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+type contextKey struct{}
+
+// WithOptions returns a copy of ctx carrying opts.
+func WithOptions(ctx context.Context, opts Options) context.Context {
+	return context.WithValue(ctx, contextKey{}, opts)
+}
+
+// FromContext returns the options attached to ctx, if any.
+func FromContext(ctx context.Context) (Options, bool) {
+	opts, ok := ctx.Value(contextKey{}).(Options)
+	return opts, ok
+}
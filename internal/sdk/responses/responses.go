@@ -0,0 +1,46 @@
+// Package responses lets the provider see the HTTP response behind an SDK
+// call. Generated resources only report a failed call with err.Error(), which
+// loses the status code and body the provider needs to point API errors at
+// the offending attribute. The SDK hooks only see the request context, so the
+// provider attaches a Recorder to it and the hooks record every response.
+package responses
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// Recorder holds the response of the last SDK call made with its context.
+type Recorder struct {
+	mu   sync.Mutex
+	last *http.Response
+}
+
+type contextKey struct{}
+
+// WithRecorder returns a copy of ctx carrying a new Recorder.
+func WithRecorder(ctx context.Context) (context.Context, *Recorder) {
+	recorder := &Recorder{}
+	return context.WithValue(ctx, contextKey{}, recorder), recorder
+}
+
+// Record stores res, which is nil for a call that got no response, in the
+// Recorder attached to ctx, if any.
+func Record(ctx context.Context, res *http.Response) {
+	recorder, ok := ctx.Value(contextKey{}).(*Recorder)
+	if !ok {
+		return
+	}
+	recorder.mu.Lock()
+	recorder.last = res
+	recorder.mu.Unlock()
+}
+
+// Last returns the response of the last SDK call, or nil if there was none
+// or it got no response.
+func (r *Recorder) Last() *http.Response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last
+}
@@ -0,0 +1,171 @@
+// Package access_token provides the seqera_access_token ephemeral resource,
+// a personal access token that exists only while a Terraform run uses it.
+package access_token
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &EphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralResource{}
+)
+
+// privateTokenID is the private data key holding the ID of the token to
+// delete on close.
+const privateTokenID = "token_id"
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{}
+}
+
+type EphemeralResource struct {
+	client *sdk.Seqera
+}
+
+type EphemeralResourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	TokenID   types.Int64  `tfsdk:"token_id"`
+	AccessKey types.String `tfsdk:"access_key"`
+}
+
+func (r *EphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Create a personal access token for the duration of a Terraform run.
+
+The token is created with ` + "`POST /tokens`" + ` when Terraform opens the ephemeral resource, and deleted with ` + "`DELETE /tokens/{tokenId}`" + ` when Terraform closes it at the end of the plan or apply. Unlike ` + "`seqera_tokens`" + `, the access key never lands in state or plan files, so it can be handed to write-only arguments and other providers:
+
+` + "```hcl" + `
+ephemeral "seqera_access_token" "agent" {}
+
+resource "kubernetes_secret_v1" "tower_agent" {
+  metadata {
+    name = "tower-agent"
+  }
+
+  data_wo = {
+    TOWER_ACCESS_TOKEN = ephemeral.seqera_access_token.agent.access_key
+  }
+  data_wo_revision = 1
+}
+` + "```" + `
+
+The token is deleted at the end of each plan and apply, so it only suits consumers done with it within the run, such as a Kubernetes job the same apply waits for. Use ` + "`seqera_tokens`" + ` for a token that must outlive the run.`,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: `Display name for the token (1-50 characters). Token names must be unique per user. Defaults to "terraform-" followed by a timestamp.`,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthBetween(1, 50),
+				},
+			},
+			"token_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: `Token numeric identifier.`,
+			},
+			"access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: `Access token value, to use as a bearer token.`,
+			},
+		},
+	}
+}
+
+func (r *EphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+func (r *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if data.Name.IsNull() {
+		name = fmt.Sprintf("terraform-%d", time.Now().UnixNano())
+	}
+
+	res, err := r.client.Tokens.CreateToken(ctx, shared.CreateAccessTokenRequest{
+		Name: &name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create access token", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.CreateAccessTokenResponse == nil ||
+		res.CreateAccessTokenResponse.AccessKey == nil || res.CreateAccessTokenResponse.Token == nil ||
+		res.CreateAccessTokenResponse.Token.ID == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "creating access token", res.RawResponse, req.Config.Raw.Type())
+		return
+	}
+	tokenID := *res.CreateAccessTokenResponse.Token.ID
+
+	privateID, err := json.Marshal(tokenID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to record access token", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateTokenID, privateID)...)
+
+	data.Name = types.StringValue(name)
+	data.TokenID = types.Int64Value(tokenID)
+	data.AccessKey = types.StringValue(*res.CreateAccessTokenResponse.AccessKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *EphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateID, diags := req.Private.GetKey(ctx, privateTokenID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateID == nil {
+		return
+	}
+	var tokenID int64
+	if err := json.Unmarshal(privateID, &tokenID); err != nil {
+		resp.Diagnostics.AddError("Failed to read access token ID", err.Error())
+		return
+	}
+
+	res, err := r.client.Tokens.DeleteToken(ctx, operations.DeleteTokenRequest{
+		TokenID: tokenID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete access token", err.Error())
+		return
+	}
+	switch res.StatusCode {
+	case http.StatusNoContent, http.StatusNotFound:
+	default:
+		resp.Diagnostics.AddError(
+			"Failed to delete access token",
+			fmt.Sprintf("%s The token %d is left on the platform.", common.UnexpectedStatusErr(ctx, "deleting access token", res.RawResponse), tokenID),
+		)
+	}
+}
@@ -0,0 +1,470 @@
+// Package agent provides the seqera_agent resource, which manages a
+// workspace AI agent definition: its name, description and instructions,
+// and whether it is enabled.
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	sdkerrors "github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/errors"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// identityAttributes identifies an agent by workspace and agent ID.
+var identityAttributes = common.WorkspaceIdentity("agent_id")
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *sdk.Seqera
+}
+
+type ResourceModel struct {
+	WorkspaceID            types.Int64  `tfsdk:"workspace_id"`
+	AgentID                types.String `tfsdk:"agent_id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Instructions           types.String `tfsdk:"instructions"`
+	InstructionsFile       types.String `tfsdk:"instructions_file"`
+	InstructionsTemplateID types.String `tfsdk:"instructions_template_id"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	Status                 types.String `tfsdk:"status"`
+	DateCreated            types.String `tfsdk:"date_created"`
+	LastUpdated            types.String `tfsdk:"last_updated"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage an AI agent in a Seqera Platform workspace.
+
+An agent is a named set of instructions that can be launched in the workspace,
+for example with the ` + "`seqera_launch_agent`" + ` action. Give the instructions
+inline with ` + "`instructions`" + `, or keep them in a file next to the pipelines
+they operate on with ` + "`instructions_file`" + `; the file is read at plan time, so
+editing it updates the agent in place.
+
+Import format: workspace_id/agent_id (e.g., "12345/agent-abc123")
+`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Computed:    true,
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"agent_id": schema.StringAttribute{
+				Computed:    true,
+				Description: `Agent string identifier.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: `Agent name.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: `Agent description.`,
+			},
+			"instructions": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: `Agent instructions. Conflicts with instructions_file; when that is set, holds the content of the file.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("instructions_file")),
+				},
+			},
+			"instructions_file": schema.StringAttribute{
+				Optional:    true,
+				Description: `Path to a file holding the agent instructions, read at plan time. Conflicts with instructions.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"instructions_template_id": schema.StringAttribute{
+				Optional:    true,
+				Description: `Identifier of the platform instructions template the agent is based on.`,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: `Whether the agent is enabled and can be launched. Defaults to true.`,
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: `Agent status: active or inactive.`,
+			},
+			"date_created": schema.StringAttribute{
+				Computed:    true,
+				Description: `RFC3339 timestamp when the agent was created.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: `RFC3339 timestamp when the agent was last updated.`,
+			},
+		},
+	}
+}
+
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Agent string identifier.`,
+	})
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+// ModifyPlan fills workspace_id from the provider default_workspace when it
+// is omitted, and plans instructions from instructions_file so that editing
+// the file shows up as a change.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var file types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("instructions_file"), &file)...)
+	if resp.Diagnostics.HasError() || file.IsNull() || file.IsUnknown() {
+		return
+	}
+	content, err := os.ReadFile(file.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("instructions_file"), "Failed to read file",
+			fmt.Sprintf("Could not read file %s: %s", file.ValueString(), err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("instructions"), string(content))...)
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueInt64()
+	res, err := r.client.Agents.CreateAgent(ctx, operations.CreateAgentRequest{
+		WorkspaceID: &workspaceID,
+		CreateAgentRequest: shared.CreateAgentRequest{
+			Name:                        data.Name.ValueStringPointer(),
+			Description:                 data.Description.ValueStringPointer(),
+			AgentInstructions:           knownString(data.Instructions),
+			AgentInstructionsTemplateID: data.InstructionsTemplateID.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create agent", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.CreateAgentResponse == nil || res.CreateAgentResponse.Agent == nil || res.CreateAgentResponse.Agent.ID == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "creating agent", res.RawResponse, req.Plan.Raw.Type())
+		return
+	}
+
+	agent := res.CreateAgentResponse.Agent
+	data.AgentID = types.StringPointerValue(agent.ID)
+	agent, err = r.setEnabled(ctx, &data, agent)
+	if err != nil {
+		// Keep the agent in state so that the next apply retries rather than
+		// creating a second one.
+		refreshFromAgent(&data, agent)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Failed to set agent status", err.Error())
+		return
+	}
+
+	refreshFromAgent(&data, agent)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueInt64()
+	res, err := r.client.Agents.DescribeAgent(ctx, operations.DescribeAgentRequest{
+		AgentID:     data.AgentID.ValueString(),
+		WorkspaceID: &workspaceID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agent", err.Error())
+		return
+	}
+	if res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.DescribeAgentResponse == nil || res.DescribeAgentResponse.Agent == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "reading agent", res.RawResponse, req.State.Raw.Type())
+		return
+	}
+	agent := res.DescribeAgentResponse.Agent
+	if agent.Status != nil && *agent.Status == shared.AgentStatusDeleted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	refreshFromAgent(&data, agent)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := plan.WorkspaceID.ValueInt64()
+	res, err := r.client.Agents.UpdateAgent(ctx, operations.UpdateAgentRequest{
+		AgentID:     plan.AgentID.ValueString(),
+		WorkspaceID: &workspaceID,
+		UpdateAgentRequest: shared.UpdateAgentRequest{
+			Name:                        plan.Name.ValueStringPointer(),
+			Description:                 plan.Description.ValueStringPointer(),
+			AgentInstructions:           knownString(plan.Instructions),
+			AgentInstructionsTemplateID: plan.InstructionsTemplateID.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update agent", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.UpdateAgentResponse == nil || res.UpdateAgentResponse.Agent == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "updating agent", res.RawResponse, req.Plan.Raw.Type())
+		return
+	}
+
+	agent, err := r.setEnabled(ctx, &plan, res.UpdateAgentResponse.Agent)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set agent status", err.Error())
+		return
+	}
+
+	refreshFromAgent(&plan, agent)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueInt64()
+	res, err := r.client.Agents.DeleteAgent(ctx, operations.DeleteAgentRequest{
+		AgentID:     data.AgentID.ValueString(),
+		WorkspaceID: &workspaceID,
+	})
+	if err != nil {
+		// DeleteAgent does not declare 404, so an agent already gone comes
+		// back as an API error.
+		var apiErr *sdkerrors.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete agent", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusNoContent {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "deleting agent", res.RawResponse, req.State.Raw.Type())
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
+		return
+	}
+
+	// Import format: workspace_id/agent_id
+	attributes, err := common.ParseImportID("seqera_agent", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	workspaceID, err := strconv.ParseInt(attributes["workspace_id"], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid workspace_id", fmt.Sprintf("workspace_id must be a number, got: %s", attributes["workspace_id"]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("agent_id"), attributes["agent_id"])...)
+}
+
+// setEnabled enables or disables the agent when its status does not match
+// the planned enabled, returning the agent as the platform reports it.
+func (r *Resource) setEnabled(ctx context.Context, data *ResourceModel, agent *shared.AgentDbDto) (*shared.AgentDbDto, error) {
+	active := agent.Status == nil || *agent.Status == shared.AgentStatusActive
+	if data.Enabled.ValueBool() == active {
+		return agent, nil
+	}
+
+	workspaceID := data.WorkspaceID.ValueInt64()
+	agentID := data.AgentID.ValueString()
+	if data.Enabled.ValueBool() {
+		res, err := r.client.Agents.EnableAgent(ctx, operations.EnableAgentRequest{AgentID: agentID, WorkspaceID: &workspaceID})
+		if err != nil {
+			return agent, err
+		}
+		if res.StatusCode != http.StatusOK || res.UpdateAgentResponse == nil || res.UpdateAgentResponse.Agent == nil {
+			return agent, common.UnexpectedStatusErr(ctx, "enabling agent", res.RawResponse)
+		}
+		return res.UpdateAgentResponse.Agent, nil
+	}
+
+	res, err := r.client.Agents.DisableAgent(ctx, operations.DisableAgentRequest{AgentID: agentID, WorkspaceID: &workspaceID})
+	if err != nil {
+		return agent, err
+	}
+	if res.StatusCode != http.StatusOK || res.UpdateAgentResponse == nil || res.UpdateAgentResponse.Agent == nil {
+		return agent, common.UnexpectedStatusErr(ctx, "disabling agent", res.RawResponse)
+	}
+	return res.UpdateAgentResponse.Agent, nil
+}
+
+// SearchAgents pages through the agents of a workspace, optionally filtered
+// by name on the server, and returns the first one match accepts.
+func SearchAgents(ctx context.Context, client *sdk.Seqera, workspaceID int64, search *string, match func(*shared.AgentDbDto) bool) (*shared.AgentDbDto, error) {
+	return common.PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]shared.AgentDbDto, int64, error) {
+			res, err := client.Agents.ListAgents(ctx, operations.ListAgentsRequest{
+				WorkspaceID: &workspaceID,
+				Search:      search,
+				Max:         &max,
+				Offset:      &offset,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing agents: %w", err)
+			}
+			if res.StatusCode != http.StatusOK || res.ListAgentsResponse == nil {
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing agents", res.RawResponse)
+			}
+			var totalSize int64
+			if res.ListAgentsResponse.TotalSize != nil {
+				totalSize = *res.ListAgentsResponse.TotalSize
+			}
+			return res.ListAgentsResponse.Agents, totalSize, nil
+		},
+		match,
+	)
+}
+
+// refreshFromAgent updates the ResourceModel from API response.
+func refreshFromAgent(data *ResourceModel, agent *shared.AgentDbDto) {
+	if agent.ID != nil {
+		data.AgentID = types.StringValue(*agent.ID)
+	}
+	if agent.WorkspaceID != nil {
+		data.WorkspaceID = types.Int64Value(*agent.WorkspaceID)
+	}
+	data.Name = types.StringPointerValue(agent.Name)
+	data.Description = optionalString(agent.Description, data.Description)
+	data.Instructions = types.StringPointerValue(agent.AgentInstructions)
+	data.InstructionsTemplateID = optionalString(agent.AgentInstructionsTemplateID, data.InstructionsTemplateID)
+	if agent.Status != nil {
+		data.Status = types.StringValue(string(*agent.Status))
+		data.Enabled = types.BoolValue(*agent.Status == shared.AgentStatusActive)
+	} else {
+		data.Status = types.StringNull()
+		if data.Enabled.IsUnknown() || data.Enabled.IsNull() {
+			data.Enabled = types.BoolValue(true)
+		}
+	}
+	data.DateCreated = rfc3339(agent.DateCreated)
+	data.LastUpdated = rfc3339(agent.LastUpdated)
+}
+
+// optionalString keeps an unset optional attribute null when the platform
+// returns an empty value for it.
+func optionalString(value *string, current types.String) types.String {
+	if (value == nil || *value == "") && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringPointerValue(value)
+}
+
+// knownString returns the value of s, or nil when it is null or unknown.
+func knownString(s types.String) *string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	return s.ValueStringPointer()
+}
+
+func rfc3339(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
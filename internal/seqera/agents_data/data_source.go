@@ -0,0 +1,147 @@
+// Package agents_data provides the seqera_agents data source.
+package agents_data
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/agent"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var _ datasource.DataSource = &DataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *sdk.Seqera
+}
+
+type agentModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Instructions           types.String `tfsdk:"instructions"`
+	InstructionsTemplateID types.String `tfsdk:"instructions_template_id"`
+	Status                 types.String `tfsdk:"status"`
+	DateCreated            types.String `tfsdk:"date_created"`
+	LastUpdated            types.String `tfsdk:"last_updated"`
+}
+
+type DataSourceModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	Search      types.String `tfsdk:"search"`
+	Agents      []agentModel `tfsdk:"agents"`
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agents"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the AI agents of a Seqera Platform workspace.
+
+Wraps ` + "`GET /agents`" + `. Use to find the ` + "`agent_id`" + ` of an agent defined outside
+Terraform, for example to launch it with the ` + "`seqera_launch_agent`" + ` action.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: `Filter agents by name. Forwarded to the platform as the ?search query parameter.`,
+			},
+			"agents": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: `Agents, in the order returned by the API.`,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                       schema.StringAttribute{Computed: true, Description: "Agent string identifier."},
+						"name":                     schema.StringAttribute{Computed: true, Description: "Agent name."},
+						"description":              schema.StringAttribute{Computed: true, Description: "Agent description."},
+						"instructions":             schema.StringAttribute{Computed: true, Description: "Agent instructions."},
+						"instructions_template_id": schema.StringAttribute{Computed: true, Description: "Identifier of the instructions template the agent is based on."},
+						"status":                   schema.StringAttribute{Computed: true, Description: "Agent status: active or inactive."},
+						"date_created":             schema.StringAttribute{Computed: true, Description: "RFC3339 timestamp when the agent was created."},
+						"last_updated":             schema.StringAttribute{Computed: true, Description: "RFC3339 timestamp when the agent was last updated."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		d.client = client
+	}
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(d.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	data.Agents = []agentModel{}
+	_, err := agent.SearchAgents(ctx, d.client, workspaceID, data.Search.ValueStringPointer(), func(a *shared.AgentDbDto) bool {
+		if a.Status != nil && *a.Status == shared.AgentStatusDeleted {
+			return false
+		}
+		data.Agents = append(data.Agents, agentModel{
+			ID:                     types.StringPointerValue(a.ID),
+			Name:                   types.StringPointerValue(a.Name),
+			Description:            types.StringPointerValue(a.Description),
+			Instructions:           types.StringPointerValue(a.AgentInstructions),
+			InstructionsTemplateID: types.StringPointerValue(a.AgentInstructionsTemplateID),
+			Status:                 types.StringPointerValue((*string)(a.Status)),
+			DateCreated:            rfc3339(a.DateCreated),
+			LastUpdated:            rfc3339(a.LastUpdated),
+		})
+		return false
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list agents", err.Error())
+		return
+	}
+	data.WorkspaceID = types.Int64Value(workspaceID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func rfc3339(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
@@ -0,0 +1,125 @@
+// Package cancel_workflow provides the seqera_cancel_workflow action, which
+// cancels a running workflow, for example one launched by
+// seqera_launch_pipeline or seqera_launch_action.
+package cancel_workflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	client *sdk.Seqera
+}
+
+type ActionModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	WorkflowID  types.String `tfsdk:"workflow_id"`
+	Force       types.Bool   `tfsdk:"force"`
+}
+
+func (a *Action) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cancel_workflow"
+}
+
+func (a *Action) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cancel a running Seqera workflow.
+
+Wraps ` + "`POST /workflow/{workflowId}/cancel`" + `. The workflow record is kept. Set ` + "`force`" + ` to cancel a workflow whose compute environment is no longer reachable.
+
+` + "```hcl" + `
+action "seqera_cancel_workflow" "stop" {
+  config {
+    workflow_id = var.workflow_id
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				Required:    true,
+				Description: `Workflow string identifier.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: `Cancel the workflow even when its compute environment is unavailable. Default: false.`,
+			},
+		},
+	}
+}
+
+func (a *Action) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		a.client = client
+	}
+}
+
+func (a *Action) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(a.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	workflowID := data.WorkflowID.ValueString()
+	res, err := a.client.Workflows.CancelWorkflow(ctx, operations.CancelWorkflowRequest{
+		WorkflowID:       workflowID,
+		WorkspaceID:      &workspaceID,
+		Force:            data.Force.ValueBoolPointer(),
+		EmptyBodyRequest: &shared.EmptyBodyRequest{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to cancel workflow", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusNoContent {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "cancelling workflow", res.RawResponse, req.Config.Raw.Type())
+		return
+	}
+	common.SendProgress(resp, fmt.Sprintf("Cancelled workflow %s", workflowID))
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/validators/stringvalidators"
+)
+
+// defaultWorkflowWaitTimeout bounds wait_for_completion when wait_timeout is
+// omitted.
+const defaultWorkflowWaitTimeout = time.Hour
+
+// defaultStudioWaitTimeout bounds the studio actions when wait_timeout is
+// omitted.
+const defaultStudioWaitTimeout = 30 * time.Minute
+
+// SendProgress reports a progress message of an action invocation, shown by
+// Terraform while the action runs.
+func SendProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+}
+
+// WorkflowWaitAttributes are the `wait_for_completion` and `wait_timeout`
+// attributes of the actions launching a workflow.
+func WorkflowWaitAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"wait_for_completion": schema.BoolAttribute{
+			Optional:    true,
+			Description: `Wait for the workflow to finish, and fail unless it succeeds. Default: false.`,
+		},
+		"wait_timeout": schema.StringAttribute{
+			Optional:    true,
+			Description: `How long to wait for the workflow to finish, as a duration such as "30m" or "2h". Only used with wait_for_completion. Default: "1h".`,
+			Validators: []validator.String{
+				stringvalidators.DurationValidator(),
+			},
+		},
+	}
+}
+
+// WaitForLaunchedWorkflow waits for a workflow launched by an action when
+// `wait_for_completion` is set, and adds an error unless it succeeds.
+func WaitForLaunchedWorkflow(ctx context.Context, client *sdk.Seqera, resp *action.InvokeResponse, workspaceID int64, workflowID string, wait types.Bool, waitTimeout types.String) {
+	if !wait.ValueBool() {
+		return
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, waitTimeoutOrDefault(waitTimeout, defaultWorkflowWaitTimeout))
+	defer cancel()
+	err := WaitForWorkflow(waitCtx, client, workspaceID, workflowID, WorkflowPollInterval, func(message string) {
+		SendProgress(resp, message)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Workflow Did Not Succeed", err.Error())
+	}
+}
+
+// StudioWaitTimeoutAttribute is the `wait_timeout` attribute of the studio
+// actions.
+func StudioWaitTimeoutAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: `How long to wait for the studio to reach the requested status, as a duration such as "10m" or "1h". Default: "30m".`,
+		Validators: []validator.String{
+			stringvalidators.DurationValidator(),
+		},
+	}
+}
+
+// WaitForStudioStatus waits for a studio to reach target, reports its final
+// status, and adds an error when it does not get there within `wait_timeout`.
+// submitted is the jobSubmitted flag of the start or stop response.
+func WaitForStudioStatus(ctx context.Context, client *sdk.Seqera, resp *action.InvokeResponse, workspaceID int64, sessionID string, target shared.DataStudioStatus, submitted bool, waitTimeout types.String) {
+	waitCtx, cancel := context.WithTimeout(ctx, waitTimeoutOrDefault(waitTimeout, defaultStudioWaitTimeout))
+	defer cancel()
+	studio, err := WaitForStudio(waitCtx, client, workspaceID, sessionID, target, submitted, StudioPollInterval, func(message string) {
+		SendProgress(resp, message)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Studio Did Not Reach Requested Status", err.Error())
+		return
+	}
+	SendProgress(resp, fmt.Sprintf("Studio %s (%s) is %s", studio.Name, sessionID, studio.Status))
+}
+
+func waitTimeoutOrDefault(waitTimeout types.String, def time.Duration) time.Duration {
+	if waitTimeout.IsNull() || waitTimeout.IsUnknown() {
+		return def
+	}
+	// Validated by DurationValidator.
+	timeout, _ := time.ParseDuration(waitTimeout.ValueString())
+	return timeout
+}
@@ -0,0 +1,205 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// APIError is the error body of a failed Seqera Platform API call.
+type APIError struct {
+	// Message is the platform's error message, empty when the body has none.
+	Message string
+	// Fields are the per-field hints of validation failures.
+	Fields []APIFieldError
+}
+
+// APIFieldError is a validation failure the platform attributes to one field
+// of the request.
+type APIFieldError struct {
+	// Field is the request field, possibly dotted, such as `workDir` or
+	// `launch.workDir`.
+	Field   string
+	Message string
+}
+
+// apiErrorBody covers the error shapes returned by the platform: a bare
+// `{"message": ...}`, and validation failures listing their fields either
+// inline or under `_embedded`.
+type apiErrorBody struct {
+	Message  string          `json:"message"`
+	Errors   []apiFieldBody  `json:"errors"`
+	Embedded *apiErrorBodies `json:"_embedded"`
+}
+
+type apiErrorBodies struct {
+	Errors []apiFieldBody `json:"errors"`
+}
+
+type apiFieldBody struct {
+	Message string `json:"message"`
+	Path    string `json:"path"`
+	Field   string `json:"field"`
+}
+
+// ParseAPIError decodes the error body of res. The body stays readable for
+// the caller.
+func ParseAPIError(res *http.Response) APIError {
+	var parsed APIError
+	if res == nil || res.Body == nil {
+		return parsed
+	}
+	content, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(content))
+	if err != nil {
+		return parsed
+	}
+
+	var body apiErrorBody
+	if err := json.Unmarshal(content, &body); err != nil {
+		return parsed
+	}
+	parsed.Message = strings.TrimSpace(body.Message)
+	fields := body.Errors
+	if body.Embedded != nil {
+		fields = append(fields, body.Embedded.Errors...)
+	}
+	for _, f := range fields {
+		name := f.Path
+		if name == "" {
+			name = f.Field
+		}
+		parsed.Fields = append(parsed.Fields, APIFieldError{Field: name, Message: strings.TrimSpace(f.Message)})
+	}
+	return parsed
+}
+
+// String returns the message and field hints on one line.
+func (e APIError) String() string {
+	parts := make([]string, 0, len(e.Fields)+1)
+	if e.Message != "" {
+		parts = append(parts, e.Message)
+	}
+	for _, f := range e.Fields {
+		switch {
+		case f.Field == "":
+			parts = append(parts, f.Message)
+		case f.Message == "":
+			parts = append(parts, f.Field)
+		default:
+			parts = append(parts, f.Field+": "+f.Message)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// attributeRule maps a known platform error message to the schema attribute
+// it is about.
+type attributeRule struct {
+	pattern   *regexp.Regexp
+	attribute string
+	summary   string
+}
+
+// attributeRules are matched in order against the error message.
+var attributeRules = []attributeRule{
+	{regexp.MustCompile(`(?i)work(ing)?[ _-]?dir`), "work_dir", "Invalid Work Directory"},
+	{regexp.MustCompile(`(?i)\bregion\b`), "region", "Invalid Region"},
+	{regexp.MustCompile(`(?i)already exists|already in use|duplicate|not unique`), "name", "Name Already In Use"},
+}
+
+// attributeError is an API error pinned to a schema attribute.
+type attributeError struct {
+	path    path.Path
+	summary string
+	detail  string
+}
+
+// attributeErrors maps the field hints and known messages of apiErr to the
+// attributes of schemaType. Errors that match no attribute are left out.
+func attributeErrors(apiErr APIError, schemaType tftypes.Type) []attributeError {
+	if schemaType == nil {
+		return nil
+	}
+
+	var mapped []attributeError
+	for _, f := range apiErr.Fields {
+		segments := strings.Split(f.Field, ".")
+		name := snakeCase(segments[len(segments)-1])
+		if p, ok := FindAttributePath(schemaType, name); ok {
+			detail := f.Message
+			if detail == "" {
+				detail = apiErr.Message
+			}
+			mapped = append(mapped, attributeError{p, "Invalid Attribute Value", detail})
+		}
+	}
+	if len(mapped) > 0 || apiErr.Message == "" {
+		return mapped
+	}
+
+	for _, rule := range attributeRules {
+		if !rule.pattern.MatchString(apiErr.Message) {
+			continue
+		}
+		if p, ok := FindAttributePath(schemaType, rule.attribute); ok {
+			return []attributeError{{p, rule.summary, apiErr.Message}}
+		}
+	}
+	return nil
+}
+
+// FindAttributePath returns the path of the attribute called name in the
+// object type t, looking through nested objects breadth first so that a
+// top-level attribute wins over a nested one. Lists, sets and maps are not
+// searched, as their element would need an index or key.
+func FindAttributePath(t tftypes.Type, name string) (path.Path, bool) {
+	type candidate struct {
+		path path.Path
+		obj  tftypes.Object
+	}
+	root, ok := t.(tftypes.Object)
+	if !ok {
+		return path.Empty(), false
+	}
+	queue := []candidate{{path.Empty(), root}}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if _, ok := next.obj.AttributeTypes[name]; ok {
+			return next.path.AtName(name), true
+		}
+		for _, attr := range slices.Sorted(maps.Keys(next.obj.AttributeTypes)) {
+			if obj, ok := next.obj.AttributeTypes[attr].(tftypes.Object); ok {
+				queue = append(queue, candidate{next.path.AtName(attr), obj})
+			}
+		}
+	}
+	return path.Empty(), false
+}
+
+// snakeCase converts an API field name such as `workDir` to the schema
+// attribute name `work_dir`.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// computeEnvType mirrors the shape of a compute environment schema:
+// `work_dir` and `region` are nested under `config`.
+var computeEnvType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"name":         tftypes.String,
+	"workspace_id": tftypes.Number,
+	"config": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"region":   tftypes.String,
+		"work_dir": tftypes.String,
+	}},
+	"label_ids": tftypes.Set{ElementType: tftypes.Number},
+}}
+
+func errorResponse(status int, body string) *http.Response {
+	req, _ := http.NewRequest(http.MethodPost, "https://api.cloud.seqera.io/compute-envs", nil)
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestAddUnexpectedStatusAttributes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status int
+		body   string
+		want   path.Path
+	}{
+		"invalid work dir": {
+			status: http.StatusBadRequest,
+			body:   `{"message":"Invalid work directory: 'bucket/work' must be an S3 path"}`,
+			want:   path.Root("config").AtName("work_dir"),
+		},
+		"invalid region": {
+			status: http.StatusBadRequest,
+			body:   `{"message":"Unknown AWS region: eu-nowhere-1"}`,
+			want:   path.Root("config").AtName("region"),
+		},
+		"duplicate name": {
+			status: http.StatusConflict,
+			body:   `{"message":"A compute environment with name 'aws' already exists"}`,
+			want:   path.Root("name"),
+		},
+		"field hint": {
+			status: http.StatusBadRequest,
+			body:   `{"message":"Bad Request","_embedded":{"errors":[{"message":"must not be blank","path":"computeEnv.config.workDir"}]}}`,
+			want:   path.Root("config").AtName("work_dir"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			AddUnexpectedStatus(context.Background(), &diags, "creating compute env", errorResponse(tc.status, tc.body), computeEnvType)
+			if len(diags) != 1 {
+				t.Fatalf("expected one diagnostic, got %v", diags)
+			}
+			withPath, ok := diags[0].(diag.DiagnosticWithPath)
+			if !ok {
+				t.Fatalf("expected an attribute diagnostic, got %v", diags[0])
+			}
+			if !withPath.Path().Equal(tc.want) {
+				t.Errorf("expected the diagnostic on %s, got %s", tc.want, withPath.Path())
+			}
+		})
+	}
+}
+
+func TestAddUnexpectedStatusUnmatched(t *testing.T) {
+	var diags diag.Diagnostics
+	res := errorResponse(http.StatusInternalServerError, `{"message":"Oops... Unable to process request"}`)
+	AddUnexpectedStatus(context.Background(), &diags, "creating compute env", res, computeEnvType)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected one diagnostic, got %v", diags)
+	}
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Errorf("expected a diagnostic without attribute, got %v", diags[0])
+	}
+	want := "Status 500 while creating compute env: Oops... Unable to process request"
+	if got := diags[0].Detail(); got != want {
+		t.Errorf("expected detail %q, got %q", want, got)
+	}
+	if body, _ := io.ReadAll(res.Body); len(body) == 0 {
+		t.Error("expected the response body to stay readable")
+	}
+}
@@ -0,0 +1,247 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+)
+
+// LabelTarget is a kind of platform entity the Labels service attaches
+// labels to.
+type LabelTarget int
+
+const (
+	LabelTargetAction LabelTarget = iota
+	LabelTargetDataset
+	LabelTargetPipeline
+	LabelTargetWorkflow
+)
+
+// labelKey identifies a label within a workspace. An empty value denotes a
+// simple label; any other value a resource label.
+type labelKey struct {
+	name, value string
+}
+
+// workspaceLabels caches the IDs of the default labels of one workspace, so
+// each is looked up or created once per provider run.
+type workspaceLabels struct {
+	mu  sync.Mutex
+	ids map[labelKey]int64
+}
+
+type workspaceLabelsKey struct {
+	client      *sdk.Seqera
+	workspaceID int64
+}
+
+var defaultLabelIDs sync.Map
+
+// DefaultLabelIDs returns the IDs of the provider `default_labels` in
+// workspaceID, sorted, creating the labels that do not exist yet. It returns
+// nil when the provider has no default labels.
+func DefaultLabelIDs(ctx context.Context, client *sdk.Seqera, workspaceID int64) ([]int64, error) {
+	labels := ProviderDefaultsFor(client).Labels
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	v, _ := defaultLabelIDs.LoadOrStore(workspaceLabelsKey{client, workspaceID}, &workspaceLabels{ids: map[labelKey]int64{}})
+	cache := v.(*workspaceLabels)
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	ids := make([]int64, 0, len(labels))
+	for name, value := range labels {
+		key := labelKey{name, value}
+		id, ok := cache.ids[key]
+		if !ok {
+			var err error
+			if id, err = resolveLabel(ctx, client, workspaceID, key); err != nil {
+				return nil, err
+			}
+			cache.ids[key] = id
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// resolveLabel finds the label matching key in the workspace, or creates it.
+func resolveLabel(ctx context.Context, client *sdk.Seqera, workspaceID int64, key labelKey) (int64, error) {
+	labelType := shared.LabelTypeSimple
+	if key.value != "" {
+		labelType = shared.LabelTypeResource
+	}
+
+	found, err := PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]shared.LabelDbDto, int64, error) {
+			res, err := client.Labels.ListLabels(ctx, operations.ListLabelsRequest{
+				WorkspaceID: &workspaceID,
+				Search:      &key.name,
+				Type:        labelType.ToPointer(),
+				Max:         &max,
+				Offset:      &offset,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing labels: %w", err)
+			}
+			if res.StatusCode != 200 || res.RawResponse == nil {
+				return nil, 0, UnexpectedStatusErr(ctx, "listing labels", res.RawResponse)
+			}
+			return DecodeLabelList(res.RawResponse.Body)
+		},
+		func(label *shared.LabelDbDto) bool {
+			if label.Name == nil || *label.Name != key.name || label.ID == nil {
+				return false
+			}
+			if key.value == "" {
+				return label.Value == nil || *label.Value == ""
+			}
+			return label.Value != nil && *label.Value == key.value
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if found != nil {
+		return *found.ID, nil
+	}
+
+	request := shared.CreateLabelRequest{
+		Name:     &key.name,
+		Resource: sdk.Bool(key.value != ""),
+	}
+	if key.value != "" {
+		request.Value = &key.value
+	}
+	res, err := client.Labels.CreateLabel(ctx, operations.CreateLabelRequest{
+		WorkspaceID:        workspaceID,
+		CreateLabelRequest: request,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("creating label %s: %w", key.name, err)
+	}
+	if res.StatusCode != 200 || res.CreateLabelResponse == nil || res.CreateLabelResponse.ID == nil {
+		return 0, UnexpectedStatusErr(ctx, "creating label "+key.name, res.RawResponse)
+	}
+	return *res.CreateLabelResponse.ID, nil
+}
+
+// DecodeLabelList reads a `GET /labels` response body. The SDK model of this
+// response is empty because the labels overlay strips the list for the
+// `seqera_labels` resource, so the body is decoded here instead.
+func DecodeLabelList(body io.Reader) ([]shared.LabelDbDto, int64, error) {
+	var page struct {
+		Labels    []shared.LabelDbDto `json:"labels"`
+		TotalSize int64               `json:"totalSize"`
+	}
+	if err := json.NewDecoder(body).Decode(&page); err != nil {
+		return nil, 0, fmt.Errorf("decoding labels: %w", err)
+	}
+	return page.Labels, page.TotalSize, nil
+}
+
+// AttachDefaultLabels adds the provider `default_labels` to the entity
+// identified by target and id, keeping the labels it already has.
+func AttachDefaultLabels(ctx context.Context, client *sdk.Seqera, workspaceID int64, target LabelTarget, id string) error {
+	labelIDs, err := DefaultLabelIDs(ctx, client, workspaceID)
+	if err != nil || len(labelIDs) == 0 {
+		return err
+	}
+	return associateLabels(ctx, client, workspaceID, target, id, labelIDs, true)
+}
+
+// DetachDefaultLabels removes the provider `default_labels` from the entity
+// identified by target and id, except those listed in keep, which the
+// configuration sets explicitly.
+func DetachDefaultLabels(ctx context.Context, client *sdk.Seqera, workspaceID int64, target LabelTarget, id string, keep []int64) error {
+	labelIDs, err := DefaultLabelIDs(ctx, client, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	var remove []int64
+	for _, labelID := range labelIDs {
+		kept := false
+		for _, k := range keep {
+			kept = kept || k == labelID
+		}
+		if !kept {
+			remove = append(remove, labelID)
+		}
+	}
+	if len(remove) == 0 {
+		return nil
+	}
+	return associateLabels(ctx, client, workspaceID, target, id, remove, false)
+}
+
+// associateLabels adds labelIDs to, or removes them from, one entity.
+func associateLabels(ctx context.Context, client *sdk.Seqera, workspaceID int64, target LabelTarget, id string, labelIDs []int64, add bool) error {
+	action := "adding default labels"
+	if !add {
+		action = "removing default labels"
+	}
+
+	var res interface {
+		GetStatusCode() int
+		GetRawResponse() *http.Response
+	}
+	var err error
+
+	switch target {
+	case LabelTargetAction:
+		body := shared.AssociateActionLabelsRequest{ActionIds: []string{id}, LabelIds: labelIDs}
+		if add {
+			res, err = client.Labels.AddLabelsToActions(ctx, operations.AddLabelsToActionsRequest{WorkspaceID: &workspaceID, AssociateActionLabelsRequest: body})
+		} else {
+			res, err = client.Labels.RemoveLabelsFromActions(ctx, operations.RemoveLabelsFromActionsRequest{WorkspaceID: &workspaceID, AssociateActionLabelsRequest: body})
+		}
+	case LabelTargetDataset:
+		body := shared.AssociateDatasetsLabelsRequest{DatasetIds: []string{id}, LabelIds: labelIDs}
+		if add {
+			res, err = client.Labels.AddLabelsToDatasets(ctx, operations.AddLabelsToDatasetsRequest{WorkspaceID: &workspaceID, AssociateDatasetsLabelsRequest: body})
+		} else {
+			res, err = client.Labels.RemoveLabelsFromDatasets(ctx, operations.RemoveLabelsFromDatasetsRequest{WorkspaceID: &workspaceID, AssociateDatasetsLabelsRequest: body})
+		}
+	case LabelTargetPipeline:
+		pipelineID, parseErr := strconv.ParseInt(id, 10, 64)
+		if parseErr != nil {
+			return fmt.Errorf("invalid pipeline ID %q", id)
+		}
+		body := shared.AssociatePipelineLabelsRequest{PipelineIds: []int64{pipelineID}, LabelIds: labelIDs}
+		if add {
+			res, err = client.Labels.AddLabelsToPipelines(ctx, operations.AddLabelsToPipelinesRequest{WorkspaceID: &workspaceID, AssociatePipelineLabelsRequest: body})
+		} else {
+			res, err = client.Labels.RemoveLabelsFromPipelines(ctx, operations.RemoveLabelsFromPipelinesRequest{WorkspaceID: &workspaceID, AssociatePipelineLabelsRequest: body})
+		}
+	case LabelTargetWorkflow:
+		body := shared.AssociateWorkflowLabelsRequest{WorkflowIds: []string{id}, LabelIds: labelIDs}
+		if add {
+			res, err = client.Labels.AddLabelsToWorkflows(ctx, operations.AddLabelsToWorkflowsRequest{WorkspaceID: &workspaceID, AssociateWorkflowLabelsRequest: body})
+		} else {
+			res, err = client.Labels.RemoveLabelsFromWorkflows(ctx, operations.RemoveLabelsFromWorkflowsRequest{WorkspaceID: &workspaceID, AssociateWorkflowLabelsRequest: body})
+		}
+	default:
+		return fmt.Errorf("unsupported label target %d", target)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", action, err)
+	}
+	if res.GetStatusCode() != 204 {
+		return UnexpectedStatusErr(ctx, action, res.GetRawResponse())
+	}
+	return nil
+}
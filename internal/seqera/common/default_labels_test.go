@@ -0,0 +1,54 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+func TestDefaultLabelIDsResolvesAndCreates(t *testing.T) {
+	var creates atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			// cost-center=1234 exists; a label named team exists with
+			// another value only.
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"labels": []map[string]any{
+					{"id": 11, "name": "cost-center", "value": "1234", "resource": true},
+					{"id": 12, "name": "team", "value": "proteomics", "resource": true},
+				},
+				"totalSize": 2,
+			})
+		case http.MethodPost:
+			creates.Add(1)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 20, "name": "team", "value": "genomics", "resource": true})
+		}
+	}))
+	defer server.Close()
+
+	client := sdk.New(sdk.WithServerURL(server.URL))
+	SetProviderDefaults(client, ProviderDefaults{Labels: map[string]string{
+		"cost-center": "1234",
+		"team":        "genomics",
+	}})
+
+	for range 2 {
+		ids, err := DefaultLabelIDs(context.Background(), client, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 2 || ids[0] != 11 || ids[1] != 20 {
+			t.Errorf("expected label IDs [11 20], got %v", ids)
+		}
+	}
+	if n := creates.Load(); n != 1 {
+		t.Errorf("expected the missing label to be created once, got %d creates", n)
+	}
+}
@@ -1,32 +1,63 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // unexpectedStatusDetail formats a detail string for an unexpected HTTP
-// status response. Includes the full request/response dump from
-// DebugResponse so users see what the server actually said. `fmt.Sprintf`
-// is unavoidable here because the action verb is interpolated.
-func unexpectedStatusDetail(action string, res *http.Response) string {
-	return fmt.Sprintf("Status %d while %s:\n%s", res.StatusCode, action, DebugResponse(res))
+// status response from the platform's error message. The full
+// request/response dump only goes to the debug log, see logUnexpectedStatus.
+// `fmt.Sprintf` is unavoidable here because the action verb is interpolated.
+func unexpectedStatusDetail(action string, res *http.Response, message string) string {
+	if message == "" {
+		return fmt.Sprintf("Status %d while %s. Run with TF_LOG=DEBUG for the full request and response.", res.StatusCode, action)
+	}
+	return fmt.Sprintf("Status %d while %s: %s", res.StatusCode, action, message)
+}
+
+// logUnexpectedStatus writes the redacted request/response dump of an
+// unexpected HTTP status to the debug log.
+func logUnexpectedStatus(ctx context.Context, action string, res *http.Response) {
+	tflog.Debug(ctx, "Unexpected API response", map[string]interface{}{
+		"action":      action,
+		"status_code": res.StatusCode,
+		"dump":        DebugResponse(res),
+	})
 }
 
 // UnexpectedStatusErr returns an error for an unexpected HTTP status,
 // suitable for return values in helper closures and worker functions.
-// The error message includes the request/response dump from DebugResponse.
-func UnexpectedStatusErr(action string, res *http.Response) error {
-	return errors.New(unexpectedStatusDetail(action, res))
+// The error message carries the platform's error message; the
+// request/response dump is logged at debug level.
+func UnexpectedStatusErr(ctx context.Context, action string, res *http.Response) error {
+	logUnexpectedStatus(ctx, action, res)
+	return errors.New(unexpectedStatusDetail(action, res, ParseAPIError(res).String()))
 }
 
-// AddUnexpectedStatus appends an "Unexpected API response" error diagnostic
-// containing the full request/response dump. Use this at every
-// non-success-status site in resource/data source methods so error messages
-// stay consistent and never drop the wire-level detail.
-func AddUnexpectedStatus(diags *diag.Diagnostics, action string, res *http.Response) {
-	diags.AddError("Unexpected API response", unexpectedStatusDetail(action, res))
+// AddUnexpectedStatus appends an error diagnostic for an unexpected HTTP
+// status. Use this at every non-success-status site in resource/data source
+// methods so error messages stay consistent. When the platform's error names
+// a field, or is a known message about one (an invalid work directory or
+// region, a duplicate name), the diagnostic is attached to the matching
+// attribute of schemaType, the type of the request's plan, state or config,
+// so Terraform points at the offending line. A nil schemaType disables the
+// mapping. The request/response dump is logged at debug level.
+func AddUnexpectedStatus(ctx context.Context, diags *diag.Diagnostics, action string, res *http.Response, schemaType tftypes.Type) {
+	logUnexpectedStatus(ctx, action, res)
+
+	apiErr := ParseAPIError(res)
+	if mapped := attributeErrors(apiErr, schemaType); len(mapped) > 0 {
+		for _, m := range mapped {
+			diags.AddAttributeError(m.path, m.summary, unexpectedStatusDetail(action, res, m.detail))
+		}
+		return
+	}
+	diags.AddError("Unexpected API response", unexpectedStatusDetail(action, res, apiErr.String()))
 }
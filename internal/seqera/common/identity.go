@@ -0,0 +1,140 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+// IdentityAttributes maps the attributes of a resource identity to the
+// top-level resource attributes holding their values. Identities name the
+// object itself `id`, scoped by `workspace_id` or `org_id`, whatever the
+// resource calls its ID attribute:
+//
+//	common.IdentityAttributes{"workspace_id": "workspace_id", "id": "compute_env_id"}
+type IdentityAttributes map[string]string
+
+// WorkspaceIdentity is the identity of a workspace-scoped object whose ID is
+// held by the resource attribute idAttribute.
+func WorkspaceIdentity(idAttribute string) IdentityAttributes {
+	return IdentityAttributes{"workspace_id": "workspace_id", "id": idAttribute}
+}
+
+// OrgIdentity is the identity of an organization-scoped object whose ID is
+// held by the resource attribute idAttribute.
+func OrgIdentity(idAttribute string) IdentityAttributes {
+	return IdentityAttributes{"org_id": "org_id", "id": idAttribute}
+}
+
+// WorkspaceIdentitySchema is the identity schema matching WorkspaceIdentity.
+// The workspace defaults to the provider default_workspace on import.
+func WorkspaceIdentitySchema(id identityschema.Attribute) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.Int64Attribute{
+				OptionalForImport: true,
+				Description:       `Workspace numeric identifier. Defaults to the provider default_workspace on import.`,
+			},
+			"id": id,
+		},
+	}
+}
+
+// OrgIdentitySchema is the identity schema matching OrgIdentity.
+func OrgIdentitySchema(id identityschema.Attribute) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Organization numeric identifier.`,
+			},
+			"id": id,
+		},
+	}
+}
+
+// SetIdentityFromState fills identity from the first of states that is not
+// null. Defer it at the top of Create, Read and Update so that every return
+// path leaves the identity in sync with the state:
+//
+//	defer common.SetIdentityFromState(ctx, attributes, resp.Identity, &resp.Diagnostics, &resp.State)
+//
+// Read also passes req.State, so a resource found gone keeps an identity
+// even when its state predates identity support.
+func SetIdentityFromState(ctx context.Context, attributes IdentityAttributes, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics, states ...*tfsdk.State) {
+	if identity == nil {
+		return
+	}
+	for _, state := range states {
+		if state == nil || state.Raw.IsNull() || !state.Raw.IsKnown() {
+			continue
+		}
+		var stateValues map[string]tftypes.Value
+		if err := state.Raw.As(&stateValues); err != nil {
+			diags.AddError("Failed to read resource identity", err.Error())
+			return
+		}
+
+		identityType, ok := identity.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		if !ok {
+			return
+		}
+		values := make(map[string]tftypes.Value, len(identityType.AttributeTypes))
+		for name, attributeType := range identityType.AttributeTypes {
+			value, ok := stateValues[attributes[name]]
+			if !ok || !value.IsKnown() {
+				value = tftypes.NewValue(attributeType, nil)
+			}
+			values[name] = value
+		}
+		identity.Raw = tftypes.NewValue(identityType, values)
+		return
+	}
+}
+
+// ImportStateFromIdentity handles an `import` block using `identity`, copying
+// each identity attribute into the resource attribute holding it, and the
+// provider default_workspace into an omitted `workspace_id`. It returns false
+// for an import by ID, which the caller still parses.
+func ImportStateFromIdentity(ctx context.Context, client *sdk.Seqera, attributes IdentityAttributes, req resource.ImportStateRequest, resp *resource.ImportStateResponse) bool {
+	if req.ID != "" || req.Identity == nil || req.Identity.Raw.IsNull() {
+		return false
+	}
+
+	var identityValues map[string]tftypes.Value
+	if err := req.Identity.Raw.As(&identityValues); err != nil {
+		resp.Diagnostics.AddError("Invalid Identity", err.Error())
+		return true
+	}
+	for name, value := range identityValues {
+		target := path.Root(attributes[name])
+		if name == "workspace_id" && value.IsNull() {
+			if workspaceID := ProviderDefaultsFor(client).WorkspaceID; workspaceID != 0 {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, target, workspaceID)...)
+			}
+			continue
+		}
+		if value.IsNull() {
+			continue
+		}
+		attributeType, diags := req.Identity.Schema.TypeAtPath(ctx, path.Root(name))
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+		attributeValue, err := attributeType.ValueFromTerraform(ctx, value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Identity", err.Error())
+			continue
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, target, attributeValue)...)
+	}
+	return true
+}
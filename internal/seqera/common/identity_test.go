@@ -0,0 +1,107 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+func identityTestSchemas() (schema.Schema, identityschema.Schema) {
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"workspace_id":   schema.Int64Attribute{Optional: true, Computed: true},
+		"compute_env_id": schema.StringAttribute{Computed: true},
+		"name":           schema.StringAttribute{Required: true},
+	}}
+	return s, WorkspaceIdentitySchema(identityschema.StringAttribute{RequiredForImport: true})
+}
+
+func TestImportStateFromIdentity(t *testing.T) {
+	ctx := context.Background()
+	s, is := identityTestSchemas()
+	stateType := s.Type().TerraformType(ctx)
+	identityType := is.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := WorkspaceIdentity("compute_env_id")
+
+	client := sdk.New()
+	SetProviderDefaults(client, ProviderDefaults{WorkspaceID: 7})
+
+	for name, tc := range map[string]struct {
+		workspaceID   any
+		wantWorkspace int64
+	}{
+		"explicit workspace": {workspaceID: 3, wantWorkspace: 3},
+		"default workspace":  {workspaceID: nil, wantWorkspace: 7},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ImportStateRequest{Identity: &tfsdk.ResourceIdentity{
+				Schema: is,
+				Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+					"workspace_id": tftypes.NewValue(tftypes.Number, tc.workspaceID),
+					"id":           tftypes.NewValue(tftypes.String, "4Xp2"),
+				}),
+			}}
+			resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(stateType, nil)}}
+
+			if !ImportStateFromIdentity(ctx, client, attributes, req, resp) {
+				t.Fatal("expected the identity to be handled")
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			var got struct {
+				WorkspaceID  int64   `tfsdk:"workspace_id"`
+				ComputeEnvID string  `tfsdk:"compute_env_id"`
+				Name         *string `tfsdk:"name"`
+			}
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("reading state: %v", diags)
+			}
+			if got.WorkspaceID != tc.wantWorkspace || got.ComputeEnvID != "4Xp2" {
+				t.Errorf("unexpected state: %+v", got)
+			}
+		})
+	}
+
+	if ImportStateFromIdentity(ctx, client, attributes, resource.ImportStateRequest{ID: "3/4Xp2"}, &resource.ImportStateResponse{}) {
+		t.Error("an import by ID should be left to the caller")
+	}
+}
+
+func TestSetIdentityFromState(t *testing.T) {
+	ctx := context.Background()
+	s, is := identityTestSchemas()
+	stateType := s.Type().TerraformType(ctx)
+	identityType := is.Type().TerraformType(ctx)
+
+	previous := tfsdk.State{Schema: s, Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"workspace_id":   tftypes.NewValue(tftypes.Number, 3),
+		"compute_env_id": tftypes.NewValue(tftypes.String, "4Xp2"),
+		"name":           tftypes.NewValue(tftypes.String, "batch"),
+	})}
+	removed := tfsdk.State{Schema: s, Raw: tftypes.NewValue(stateType, nil)}
+	identity := &tfsdk.ResourceIdentity{Schema: is, Raw: tftypes.NewValue(identityType, nil)}
+
+	var diags diag.Diagnostics
+	SetIdentityFromState(ctx, WorkspaceIdentity("compute_env_id"), identity, &diags, &removed, &previous)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var got struct {
+		WorkspaceID int64  `tfsdk:"workspace_id"`
+		ID          string `tfsdk:"id"`
+	}
+	if diags := identity.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading identity: %v", diags)
+	}
+	if got.WorkspaceID != 3 || got.ID != "4Xp2" {
+		t.Errorf("unexpected identity: %+v", got)
+	}
+}
@@ -0,0 +1,397 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// importIDFormat is how the ImportState of a resource reads a `terraform
+// import` ID. Attribute values are strings keyed by resource attribute, as
+// taken and returned by the parse_import_id and format_import_id functions.
+type importIDFormat struct {
+	// Syntax shows the format in error messages, e.g. `org_id/email`.
+	Syntax string
+
+	Parse  func(id string) (map[string]string, error)
+	Format func(attributes map[string]string) (string, error)
+}
+
+// importIDField is an attribute encoded in an import ID.
+type importIDField struct {
+	Name   string
+	Number bool
+}
+
+func stringField(name string) importIDField { return importIDField{Name: name} }
+func numberField(name string) importIDField { return importIDField{Name: name, Number: true} }
+
+// check reports a value the resource could not parse back.
+func (f importIDField) check(value string) error {
+	if value == "" {
+		return fmt.Errorf("%s must not be empty", f.Name)
+	}
+	if f.Number {
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%s must be a number, got: %s", f.Name, value)
+		}
+	}
+	return nil
+}
+
+// importIDFormats holds the import ID format of every importable resource.
+// Hand-written resources parse their ID with ParseImportID; a provider test
+// checks that every importable resource has an entry.
+var importIDFormats = map[string]importIDFormat{}
+
+func init() {
+	computeEnv := jsonImportID(stringField("compute_env_id"), numberField("workspace_id"))
+	for _, name := range []string{
+		"aws_batch_ce", "aws_cloud_ce", "aws_compute_env", "azure_batch_ce", "azure_cloud_ce",
+		"compute_env", "gcp_batch_ce", "gcp_cloud_ce", "managed_compute_ce", "slurm_ce",
+	} {
+		importIDFormats["seqera_"+name] = computeEnv
+	}
+	credential := jsonImportID(stringField("credentials_id"), numberField("workspace_id"))
+	for _, name := range []string{
+		"aws_credential", "azure_cloud_credential", "azure_credential", "azure_entra_credential",
+		"bitbucket_credential", "codecommit_credential", "container_registry_credential",
+		"gitea_credential", "github_app_credential", "github_credential", "gitlab_credential",
+		"google_credential", "kubernetes_credential", "ssh_credential", "tower_agent_credential",
+	} {
+		importIDFormats["seqera_"+name] = credential
+	}
+
+	for name, format := range map[string]importIDFormat{
+		"seqera_action":                      plainImportID(stringField("action_id")),
+		"seqera_agent":                       pathImportID(numberField("workspace_id"), stringField("agent_id")),
+		"seqera_credential":                  plainImportID(stringField("credentials_id")),
+		"seqera_custom_role":                 jsonImportID(stringField("name"), numberField("org_id")),
+		"seqera_data_link":                   plainImportID(stringField("data_link_id")),
+		"seqera_dataset_version":             pathImportID(numberField("workspace_id"), stringField("dataset_id"), numberField("version")),
+		"seqera_datasets":                    pathImportID(numberField("workspace_id"), stringField("dataset")),
+		"seqera_labels":                      labelImportID(),
+		"seqera_managed_identity":            pathImportID(numberField("org_id"), numberField("managed_identity_id")),
+		"seqera_managed_identity_credential": pathImportID(numberField("org_id"), numberField("managed_identity_id"), numberField("managed_credentials_id")),
+		"seqera_organization_idp_group":      pathImportID(numberField("org_id"), numberField("group_id")),
+		"seqera_organization_member":         pathImportID(numberField("org_id"), stringField("email")),
+		"seqera_organization_scim":           plainImportID(numberField("org_id")),
+		"seqera_orgs":                        plainImportID(numberField("org_id")),
+		"seqera_pipeline":                    jsonImportID(numberField("pipeline_id"), numberField("workspace_id")),
+		"seqera_pipeline_secret":             jsonImportID(numberField("secret_id"), numberField("workspace_id")),
+		"seqera_ssh_key":                     idOrNameImportID("key_id"),
+		"seqera_studios":                     plainImportID(stringField("session_id")),
+		"seqera_team_member":                 pathImportID(numberField("org_id"), numberField("team_id"), stringField("email")),
+		"seqera_teams":                       jsonImportID(numberField("org_id"), numberField("team_id")),
+		"seqera_tokens":                      idOrNameImportID("id"),
+		"seqera_workflows":                   plainImportID(stringField("workflow_id")),
+		"seqera_workspace":                   jsonImportID(numberField("id"), numberField("org_id")),
+		"seqera_workspace_participant":       participantImportID(),
+	} {
+		importIDFormats[name] = format
+	}
+}
+
+// ImportIDResourceTypes lists the resource types with an import ID format.
+func ImportIDResourceTypes() []string {
+	names := make([]string, 0, len(importIDFormats))
+	for name := range importIDFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseImportID splits the import ID of a resource type into attribute
+// values.
+func ParseImportID(resourceType, id string) (map[string]string, error) {
+	format, err := importIDFormatFor(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := format.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s import ID, expected %s: %w", resourceType, format.Syntax, err)
+	}
+	return attributes, nil
+}
+
+// FormatImportID builds the import ID of a resource type from attribute
+// values.
+func FormatImportID(resourceType string, attributes map[string]string) (string, error) {
+	format, err := importIDFormatFor(resourceType)
+	if err != nil {
+		return "", err
+	}
+	id, err := format.Format(attributes)
+	if err != nil {
+		return "", fmt.Errorf("cannot format %s import ID %s: %w", resourceType, format.Syntax, err)
+	}
+	return id, nil
+}
+
+// ErrNoImportID is returned for a resource type without an import ID format.
+var ErrNoImportID = errors.New("resource type has no import ID")
+
+func importIDFormatFor(resourceType string) (importIDFormat, error) {
+	format, ok := importIDFormats[resourceType]
+	if !ok {
+		return importIDFormat{}, fmt.Errorf("%w: %q, expected one of: %s", ErrNoImportID, resourceType, strings.Join(ImportIDResourceTypes(), ", "))
+	}
+	return format, nil
+}
+
+// takeAttributes checks that attributes holds the fields, and no others
+// besides the optional ones.
+func takeAttributes(attributes map[string]string, fields []importIDField, optional ...string) error {
+	for _, field := range fields {
+		value, ok := attributes[field.Name]
+		if !ok {
+			return fmt.Errorf("missing attribute %s", field.Name)
+		}
+		if err := field.check(value); err != nil {
+			return err
+		}
+	}
+	for name := range attributes {
+		known := false
+		for _, field := range fields {
+			known = known || field.Name == name
+		}
+		for _, field := range optional {
+			known = known || field == name
+		}
+		if !known {
+			return fmt.Errorf("unexpected attribute %s", name)
+		}
+	}
+	return nil
+}
+
+// jsonImportID is the JSON object ID of the generated resources, e.g.
+// `{"compute_env_id": "...", "workspace_id": 0}`.
+func jsonImportID(fields ...importIDField) importIDFormat {
+	example := make([]string, len(fields))
+	for i, field := range fields {
+		value := `"..."`
+		if field.Number {
+			value = "0"
+		}
+		example[i] = fmt.Sprintf("%q: %s", field.Name, value)
+	}
+
+	return importIDFormat{
+		Syntax: "{" + strings.Join(example, ", ") + "}",
+		Parse: func(id string) (map[string]string, error) {
+			dec := json.NewDecoder(strings.NewReader(id))
+			dec.UseNumber()
+			var object map[string]any
+			if err := dec.Decode(&object); err != nil {
+				return nil, err
+			}
+			attributes := make(map[string]string, len(object))
+			for name, value := range object {
+				switch value := value.(type) {
+				case string:
+					attributes[name] = value
+				case json.Number:
+					attributes[name] = value.String()
+				default:
+					return nil, fmt.Errorf("%s must be a string or a number", name)
+				}
+			}
+			if err := takeAttributes(attributes, fields); err != nil {
+				return nil, err
+			}
+			return attributes, nil
+		},
+		Format: func(attributes map[string]string) (string, error) {
+			if err := takeAttributes(attributes, fields); err != nil {
+				return "", err
+			}
+			// Keys are written in field order, which is the alphabetical
+			// order the generated import examples use.
+			var buf bytes.Buffer
+			buf.WriteString("{")
+			for i, field := range fields {
+				if i > 0 {
+					buf.WriteString(", ")
+				}
+				key, _ := json.Marshal(field.Name)
+				buf.Write(key)
+				buf.WriteString(": ")
+				if field.Number {
+					buf.WriteString(attributes[field.Name])
+				} else {
+					value, _ := json.Marshal(attributes[field.Name])
+					buf.Write(value)
+				}
+			}
+			buf.WriteString("}")
+			return buf.String(), nil
+		},
+	}
+}
+
+// plainImportID is an ID holding a single attribute.
+func plainImportID(field importIDField) importIDFormat {
+	return pathImportID(field)
+}
+
+// pathImportID is a slash-separated ID, e.g. `org_id/email`. The last part
+// keeps any further slashes.
+func pathImportID(fields ...importIDField) importIDFormat {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name
+	}
+
+	return importIDFormat{
+		Syntax: strings.Join(names, "/"),
+		Parse: func(id string) (map[string]string, error) {
+			parts := strings.SplitN(id, "/", len(fields))
+			if len(parts) != len(fields) {
+				return nil, fmt.Errorf("got: %s", id)
+			}
+			attributes := make(map[string]string, len(fields))
+			for i, field := range fields {
+				attributes[field.Name] = parts[i]
+			}
+			if err := takeAttributes(attributes, fields); err != nil {
+				return nil, err
+			}
+			return attributes, nil
+		},
+		Format: func(attributes map[string]string) (string, error) {
+			if err := takeAttributes(attributes, fields); err != nil {
+				return "", err
+			}
+			parts := make([]string, len(fields))
+			for i, field := range fields {
+				parts[i] = attributes[field.Name]
+			}
+			return strings.Join(parts, "/"), nil
+		},
+	}
+}
+
+// labelImportID is `workspace_id/name`, or `workspace_id/name=value` for a
+// resource label.
+func labelImportID() importIDFormat {
+	path := pathImportID(numberField("workspace_id"), stringField("label"))
+	fields := []importIDField{numberField("workspace_id"), stringField("name")}
+
+	return importIDFormat{
+		Syntax: "workspace_id/name or workspace_id/name=value",
+		Parse: func(id string) (map[string]string, error) {
+			attributes, err := path.Parse(id)
+			if err != nil {
+				return nil, err
+			}
+			name, value, hasValue := strings.Cut(attributes["label"], "=")
+			delete(attributes, "label")
+			attributes["name"] = name
+			if hasValue {
+				attributes["value"] = value
+			}
+			return attributes, takeAttributes(attributes, fields, "value")
+		},
+		Format: func(attributes map[string]string) (string, error) {
+			if err := takeAttributes(attributes, fields, "value"); err != nil {
+				return "", err
+			}
+			id := attributes["workspace_id"] + "/" + attributes["name"]
+			if value := attributes["value"]; value != "" {
+				id += "=" + value
+			}
+			return id, nil
+		},
+	}
+}
+
+// idOrNameImportID is the numeric ID held by idAttribute, or the name, for
+// objects named uniquely per user.
+func idOrNameImportID(idAttribute string) importIDFormat {
+	return importIDFormat{
+		Syntax: "id or name",
+		Parse: func(id string) (map[string]string, error) {
+			if id == "" {
+				return nil, fmt.Errorf("id or name must not be empty")
+			}
+			if _, err := strconv.ParseInt(id, 10, 64); err == nil {
+				return map[string]string{idAttribute: id}, nil
+			}
+			return map[string]string{"name": id}, nil
+		},
+		Format: func(attributes map[string]string) (string, error) {
+			if id, ok := attributes[idAttribute]; ok && len(attributes) == 1 {
+				return id, numberField(idAttribute).check(id)
+			}
+			if name, ok := attributes["name"]; ok && len(attributes) == 1 {
+				return name, stringField("name").check(name)
+			}
+			return "", fmt.Errorf("expected exactly one of %s or name", idAttribute)
+		},
+	}
+}
+
+// participantImportID is `org_id/workspace_id/email`, with `team:team_id` or
+// `member:member_id` in place of the email for a team or member ID.
+func participantImportID() importIDFormat {
+	path := pathImportID(numberField("org_id"), numberField("workspace_id"), stringField("participant"))
+	scope := []importIDField{numberField("org_id"), numberField("workspace_id")}
+	prefixes := map[string]string{"team_id": "team:", "member_id": "member:"}
+
+	return importIDFormat{
+		Syntax: "org_id/workspace_id/email, org_id/workspace_id/team:team_id or org_id/workspace_id/member:member_id",
+		Parse: func(id string) (map[string]string, error) {
+			attributes, err := path.Parse(id)
+			if err != nil {
+				return nil, err
+			}
+			participant := attributes["participant"]
+			delete(attributes, "participant")
+			name := "email"
+			for field, prefix := range prefixes {
+				if value, ok := strings.CutPrefix(participant, prefix); ok {
+					name, participant = field, value
+					if err := numberField(field).check(value); err != nil {
+						return nil, err
+					}
+				}
+			}
+			attributes[name] = participant
+			return attributes, nil
+		},
+		Format: func(attributes map[string]string) (string, error) {
+			if err := takeAttributes(attributes, scope, "email", "team_id", "member_id"); err != nil {
+				return "", err
+			}
+			var participants []string
+			for _, name := range []string{"email", "team_id", "member_id"} {
+				value, ok := attributes[name]
+				if !ok {
+					continue
+				}
+				field := stringField(name)
+				if prefix, ok := prefixes[name]; ok {
+					field = numberField(name)
+					participants = append(participants, prefix+value)
+				} else {
+					participants = append(participants, value)
+				}
+				if err := field.check(value); err != nil {
+					return "", err
+				}
+			}
+			if len(participants) != 1 {
+				return "", fmt.Errorf("expected exactly one of email, team_id or member_id")
+			}
+			return attributes["org_id"] + "/" + attributes["workspace_id"] + "/" + participants[0], nil
+		},
+	}
+}
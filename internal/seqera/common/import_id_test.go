@@ -0,0 +1,73 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestImportIDRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		resourceType string
+		id           string
+		attributes   map[string]string
+	}{
+		{"seqera_aws_batch_ce", `{"compute_env_id": "4Xp2", "workspace_id": 12}`, map[string]string{"compute_env_id": "4Xp2", "workspace_id": "12"}},
+		{"seqera_workspace", `{"id": 3, "org_id": 7}`, map[string]string{"id": "3", "org_id": "7"}},
+		{"seqera_orgs", "7", map[string]string{"org_id": "7"}},
+		{"seqera_action", "5aXc", map[string]string{"action_id": "5aXc"}},
+		{"seqera_agent", "12/ag-7f", map[string]string{"workspace_id": "12", "agent_id": "ag-7f"}},
+		{"seqera_managed_identity", "7/3", map[string]string{"org_id": "7", "managed_identity_id": "3"}},
+		{"seqera_managed_identity_credential", "7/3/21", map[string]string{"org_id": "7", "managed_identity_id": "3", "managed_credentials_id": "21"}},
+		{"seqera_organization_idp_group", "7/15", map[string]string{"org_id": "7", "group_id": "15"}},
+		{"seqera_organization_scim", "7", map[string]string{"org_id": "7"}},
+		{"seqera_team_member", "7/9/user@example.com", map[string]string{"org_id": "7", "team_id": "9", "email": "user@example.com"}},
+		{"seqera_labels", "12/team", map[string]string{"workspace_id": "12", "name": "team"}},
+		{"seqera_labels", "12/env=prod", map[string]string{"workspace_id": "12", "name": "env", "value": "prod"}},
+		{"seqera_tokens", "42", map[string]string{"id": "42"}},
+		{"seqera_tokens", "ci", map[string]string{"name": "ci"}},
+		{"seqera_ssh_key", "8", map[string]string{"key_id": "8"}},
+		{"seqera_ssh_key", "laptop", map[string]string{"name": "laptop"}},
+		{"seqera_workspace_participant", "7/12/user@example.com", map[string]string{"org_id": "7", "workspace_id": "12", "email": "user@example.com"}},
+		{"seqera_workspace_participant", "7/12/team:99", map[string]string{"org_id": "7", "workspace_id": "12", "team_id": "99"}},
+	} {
+		got, err := ParseImportID(tc.resourceType, tc.id)
+		if err != nil {
+			t.Errorf("parse %s %q: %v", tc.resourceType, tc.id, err)
+		} else if !reflect.DeepEqual(got, tc.attributes) {
+			t.Errorf("parse %s %q: got %v", tc.resourceType, tc.id, got)
+		}
+
+		id, err := FormatImportID(tc.resourceType, tc.attributes)
+		if err != nil || id != tc.id {
+			t.Errorf("format %s %v: got %q, %v", tc.resourceType, tc.attributes, id, err)
+		}
+	}
+}
+
+func TestImportIDInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		resourceType string
+		id           string
+	}{
+		{"seqera_unknown", "1"},
+		{"seqera_orgs", "my-org"},
+		{"seqera_pipeline", `{"pipeline_id": 1}`},
+		{"seqera_pipeline", `{"pipeline_id": 1, "workspace_id": 2, "name": "x"}`},
+		{"seqera_organization_member", "7"},
+		{"seqera_workspace_participant", "7/12/team:core"},
+	} {
+		if _, err := ParseImportID(tc.resourceType, tc.id); err == nil {
+			t.Errorf("parse %s %q: expected an error", tc.resourceType, tc.id)
+		}
+	}
+
+	for _, attributes := range []map[string]string{
+		{"workspace_id": "12"},
+		{"workspace_id": "12", "dataset": "samples", "version": "1"},
+		{"workspace_id": "ws", "dataset": "samples"},
+	} {
+		if _, err := FormatImportID("seqera_datasets", attributes); err == nil {
+			t.Errorf("format %v: expected an error", attributes)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	pathpkg "path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+// ListItem is a platform object found by a list resource.
+type ListItem struct {
+	// DisplayName is shown by `terraform query`.
+	DisplayName string
+
+	// Attributes are resource attribute values keyed by name. They hold at
+	// least the identity attributes, and seed the state read when the query
+	// includes resources.
+	Attributes map[string]attr.Value
+}
+
+// ListWalker calls yield with each object a list resource finds, until yield
+// returns false.
+type ListWalker func(ctx context.Context, yield func(ListItem) bool) error
+
+// StreamListResults streams the items of walk as list results, up to
+// req.Limit, with identities built from the item attributes as described by
+// identity. When the query includes resources, each result carries the state
+// returned by read, the Read method of the matching resource, from a state
+// seeded with the item attributes.
+func StreamListResults(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream, identity IdentityAttributes, walk ListWalker, read func(context.Context, resource.ReadRequest, *resource.ReadResponse)) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		err := walk(ctx, func(item ListItem) bool {
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.DisplayName
+			for name := range req.ResourceIdentitySchema.GetAttributes() {
+				if value, ok := item.Attributes[identity[name]]; ok {
+					result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(name), value)...)
+				}
+			}
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				readListedResource(ctx, req, item, &result, read)
+			}
+
+			if !push(result) {
+				return false
+			}
+			count++
+			return req.Limit <= 0 || count < req.Limit
+		})
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Failed to list objects", err.Error())
+			push(list.ListResult{Diagnostics: diags})
+		}
+	}
+}
+
+func readListedResource(ctx context.Context, req list.ListRequest, item ListItem, result *list.ListResult, read func(context.Context, resource.ReadRequest, *resource.ReadResponse)) {
+	state := tfsdk.State{
+		Schema: req.ResourceSchema,
+		Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range item.Attributes {
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	identity := &tfsdk.ResourceIdentity{Schema: result.Identity.Schema, Raw: result.Identity.Raw.Copy()}
+	readResp := resource.ReadResponse{State: state, Identity: identity}
+	read(ctx, resource.ReadRequest{State: state, Identity: identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if readResp.State.Raw.IsNull() {
+		result.Diagnostics.AddWarning("Object Not Found", fmt.Sprintf("%s was deleted while it was being listed.", item.DisplayName))
+		return
+	}
+	result.Resource.Raw = readResp.State.Raw
+}
+
+// ListWorkspaceAttribute is the `workspace_id` filter of the list resources
+// for workspace-scoped objects.
+func ListWorkspaceAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: `Workspace numeric identifier to list from. Defaults to the provider default_workspace when omitted.`,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// ListNameAttribute is the `name` filter of the list resources.
+func ListNameAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: `Only list objects whose name matches this glob pattern, such as "prod-*". Supports *, ? and [...] character classes.`,
+	}
+}
+
+// ListWorkspace resolves the `workspace_id` filter of a list resource,
+// falling back to the provider default_workspace.
+func ListWorkspace(client *sdk.Seqera, workspaceID types.Int64) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id, ok := WorkspaceOrDefault(client, workspaceID)
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+	}
+	return id, diags
+}
+
+// NameFilter returns a function reporting whether a name matches the `name`
+// glob of a list resource. Every name matches a null pattern.
+func NameFilter(pattern types.String) (func(name string) bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if pattern.IsNull() || pattern.IsUnknown() {
+		return func(string) bool { return true }, diags
+	}
+	glob := pattern.ValueString()
+	if _, err := pathpkg.Match(glob, ""); err != nil {
+		diags.AddAttributeError(path.Root("name"), "Invalid Name Pattern", fmt.Sprintf("%q is not a valid glob pattern: %s", glob, err))
+		return nil, diags
+	}
+	return func(name string) bool {
+		matched, _ := pathpkg.Match(glob, name)
+		return matched
+	}, diags
+}
+
+// StringFilter returns a function reporting whether a value equals the
+// filter, ignoring case. Every value matches a null filter, and a nil value
+// matches no other filter.
+func StringFilter(filter types.String) func(value *string) bool {
+	if filter.IsNull() || filter.IsUnknown() {
+		return func(*string) bool { return true }
+	}
+	want := filter.ValueString()
+	return func(value *string) bool {
+		return value != nil && strings.EqualFold(*value, want)
+	}
+}
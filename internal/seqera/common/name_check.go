@@ -0,0 +1,102 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+// NameValidator calls one of the Validate*Name operations for name, within
+// the workspace or organization scopeID (nil when the plan has none). It
+// returns the HTTP status and, for a rejected name, the server message.
+type NameValidator func(ctx context.Context, client *sdk.Seqera, scopeID *int64, name string) (status int, message string, err error)
+
+// NameCheck describes where a resource keeps the name the server validates.
+type NameCheck struct {
+	// Kind names the object in diagnostics, such as "compute environment".
+	Kind string
+
+	// Name is the path of the name attribute.
+	Name path.Path
+
+	// Scope is the path of the `workspace_id` or `org_id` attribute the name
+	// must be unique within, or empty for names unique per user.
+	Scope path.Path
+
+	// Adoptable is set for resources whose Create takes an existing object
+	// of the same name into state under the provider `adopt_on_conflict`.
+	Adoptable bool
+
+	Validate NameValidator
+}
+
+// CheckNameAvailable asks the server whether the planned name is free when a
+// resource is created or renamed, so a conflict fails `terraform plan`
+// rather than an apply that may already have created other resources. Call
+// it from ModifyPlan after ApplyDefaultWorkspace, which plans the scope.
+//
+// A taken or invalid name is an error on the name attribute, except that a
+// taken name is only a warning when an Adoptable resource is created with
+// `adopt_on_conflict` set, since apply will adopt the object. The check is
+// skipped while the name or scope is unknown, and a server the token cannot
+// ask (403) or cannot reach is left for apply to report.
+func CheckNameAvailable(ctx context.Context, client *sdk.Seqera, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, check NameCheck) {
+	if client == nil || req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, check.Name, &name)...)
+	if resp.Diagnostics.HasError() || name.IsNull() || name.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var current types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, check.Name, &current)...)
+		if resp.Diagnostics.HasError() || current.Equal(name) {
+			return
+		}
+	}
+
+	var scope types.Int64
+	if len(check.Scope.Steps()) > 0 {
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, check.Scope, &scope)...)
+		if resp.Diagnostics.HasError() || scope.IsUnknown() {
+			return
+		}
+	}
+
+	status, message, err := check.Validate(ctx, client, scope.ValueInt64Pointer(), name.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Name availability check failed", map[string]interface{}{
+			"kind":  check.Kind,
+			"error": err.Error(),
+		})
+		return
+	}
+
+	switch status {
+	case http.StatusConflict:
+		if message == "" {
+			message = fmt.Sprintf("A %s named %q already exists.", check.Kind, name.ValueString())
+		}
+		if check.Adoptable && req.State.Raw.IsNull() && ProviderDefaultsFor(client).AdoptOnConflict {
+			resp.Diagnostics.AddAttributeWarning(check.Name, "Existing Object Will Be Adopted",
+				message+" The provider sets adopt_on_conflict, so apply will take it into state and update it to match the configuration.")
+			return
+		}
+		resp.Diagnostics.AddAttributeError(check.Name, "Name Already In Use", message)
+	case http.StatusBadRequest:
+		if message == "" {
+			message = fmt.Sprintf("%q is not a valid %s name.", name.ValueString(), check.Kind)
+		}
+		resp.Diagnostics.AddAttributeError(check.Name, "Invalid Name", message)
+	}
+}
@@ -0,0 +1,177 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+func TestCheckNameAvailable(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"workspace_id": schema.Int64Attribute{Optional: true, Computed: true},
+		"name":         schema.StringAttribute{Required: true},
+	}}
+	objectType := s.Type().TerraformType(ctx)
+	value := func(workspaceID, name any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"workspace_id": tftypes.NewValue(tftypes.Number, workspaceID),
+			"name":         tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	none := tftypes.NewValue(objectType, nil)
+
+	taken := map[string]bool{"prod": true}
+	var calls int
+	check := NameCheck{
+		Kind:  "compute environment",
+		Name:  path.Root("name"),
+		Scope: path.Root("workspace_id"),
+		Validate: func(ctx context.Context, client *sdk.Seqera, workspaceID *int64, name string) (int, string, error) {
+			calls++
+			if workspaceID == nil || *workspaceID != 7 {
+				t.Errorf("expected workspace 7, got %v", workspaceID)
+			}
+			switch {
+			case taken[name]:
+				return http.StatusConflict, "", nil
+			case name == "bad name":
+				return http.StatusBadRequest, "Invalid compute environment name", nil
+			}
+			return http.StatusNoContent, "", nil
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		plan, state tftypes.Value
+		wantCall    bool
+		wantError   string
+	}{
+		"free on create":  {plan: value(7, "dev"), state: none, wantCall: true},
+		"taken on create": {plan: value(7, "prod"), state: none, wantCall: true, wantError: "Name Already In Use"},
+		"invalid":         {plan: value(7, "bad name"), state: none, wantCall: true, wantError: "Invalid Name"},
+		"taken on rename": {plan: value(7, "prod"), state: value(7, "dev"), wantCall: true, wantError: "Name Already In Use"},
+		"unchanged":       {plan: value(7, "prod"), state: value(7, "prod")},
+		"unknown name":    {plan: value(7, tftypes.UnknownValue), state: none},
+		"unknown scope":   {plan: value(tftypes.UnknownValue, "prod"), state: none},
+		"destroy":         {plan: none, state: value(7, "prod")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			calls = 0
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: tc.plan},
+				State: tfsdk.State{Schema: s, Raw: tc.state},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			CheckNameAvailable(ctx, sdk.New(), req, resp, check)
+
+			if (calls > 0) != tc.wantCall {
+				t.Errorf("expected validation call %v, got %d calls", tc.wantCall, calls)
+			}
+			switch {
+			case tc.wantError == "" && resp.Diagnostics.HasError():
+				t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+			case tc.wantError != "" && (!resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tc.wantError):
+				t.Errorf("expected %q, got %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCheckNameAvailableAdoptOnConflict(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"name":         schema.StringAttribute{Required: true},
+		"workspace_id": schema.Int64Attribute{Required: true},
+	}}
+	objectType := s.Type().TerraformType(ctx)
+	value := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":         tftypes.NewValue(tftypes.String, name),
+			"workspace_id": tftypes.NewValue(tftypes.Number, 7),
+		})
+	}
+	none := tftypes.NewValue(objectType, nil)
+
+	check := NameCheck{
+		Kind:  "credential",
+		Name:  path.Root("name"),
+		Scope: path.Root("workspace_id"),
+		Validate: func(ctx context.Context, client *sdk.Seqera, scopeID *int64, name string) (int, string, error) {
+			return http.StatusConflict, "", nil
+		},
+	}
+	adopting := sdk.New()
+	SetProviderDefaults(adopting, ProviderDefaults{AdoptOnConflict: true})
+
+	for name, tc := range map[string]struct {
+		client      *sdk.Seqera
+		adoptable   bool
+		state       tftypes.Value
+		wantError   bool
+		wantWarning bool
+	}{
+		"adopted on create":      {client: adopting, adoptable: true, state: none, wantWarning: true},
+		"not adoptable":          {client: adopting, state: none, wantError: true},
+		"adoption off":           {client: sdk.New(), adoptable: true, state: none, wantError: true},
+		"rename is not a create": {client: adopting, adoptable: true, state: value("dev"), wantError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			check := check
+			check.Adoptable = tc.adoptable
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: value("prod")},
+				State: tfsdk.State{Schema: s, Raw: tc.state},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			CheckNameAvailable(ctx, tc.client, req, resp, check)
+
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error %v, got %v", tc.wantError, resp.Diagnostics)
+			}
+			if (resp.Diagnostics.WarningsCount() > 0) != tc.wantWarning {
+				t.Errorf("expected warning %v, got %v", tc.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCheckNameAvailableUnscoped(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+	}}
+	objectType := s.Type().TerraformType(ctx)
+	plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "laptop"),
+	})
+
+	check := NameCheck{
+		Kind: "SSH key",
+		Name: path.Root("name"),
+		Validate: func(ctx context.Context, client *sdk.Seqera, scopeID *int64, name string) (int, string, error) {
+			if scopeID != nil {
+				t.Errorf("expected no scope, got %d", *scopeID)
+			}
+			return http.StatusConflict, "", nil
+		},
+	}
+	req := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: plan},
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	CheckNameAvailable(ctx, sdk.New(), req, resp, check)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Name Already In Use" {
+		t.Errorf("expected a name conflict, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,173 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+)
+
+// ProviderDefaults holds provider-level settings resolved at Configure time
+// that resources consult while planning.
+type ProviderDefaults struct {
+	// WorkspaceID is the resolved `default_workspace`, or 0 when unset.
+	WorkspaceID int64
+
+	// WorkspaceUnknown is set when `default_workspace` depends on values
+	// only known after apply.
+	WorkspaceUnknown bool
+
+	// Labels is `default_labels`: label values keyed by label name, with an
+	// empty value for a simple label.
+	Labels map[string]string
+
+	// AdoptOnConflict is `adopt_on_conflict`: name-keyed resources whose
+	// create fails with 409 take the existing object into state.
+	AdoptOnConflict bool
+}
+
+// providerDefaults maps each configured SDK client to its defaults. Keying
+// by client rather than storing the defaults in the provider data keeps
+// `*sdk.Seqera` as the provider data type every resource expects, and gives
+// each provider alias its own entry.
+var providerDefaults sync.Map
+
+// SetProviderDefaults records the defaults of the provider that created client.
+func SetProviderDefaults(client *sdk.Seqera, defaults ProviderDefaults) {
+	providerDefaults.Store(client, defaults)
+}
+
+// ProviderDefaultsFor returns the defaults recorded for client, or the zero
+// value when there are none.
+func ProviderDefaultsFor(client *sdk.Seqera) ProviderDefaults {
+	if client == nil {
+		return ProviderDefaults{}
+	}
+	if defaults, ok := providerDefaults.Load(client); ok {
+		return defaults.(ProviderDefaults)
+	}
+	return ProviderDefaults{}
+}
+
+// WorkspaceOrDefault returns workspaceID, or the provider
+// `default_workspace` when workspaceID is null. ok is false when neither is
+// set. Use it where there is no plan to fill in, such as in actions.
+func WorkspaceOrDefault(client *sdk.Seqera, workspaceID types.Int64) (id int64, ok bool) {
+	if !workspaceID.IsNull() && !workspaceID.IsUnknown() {
+		return workspaceID.ValueInt64(), true
+	}
+	id = ProviderDefaultsFor(client).WorkspaceID
+	return id, id != 0
+}
+
+// ResolveWorkspace turns a workspace reference, either a numeric ID or an
+// `org/workspace` full name, into a workspace ID.
+func ResolveWorkspace(ctx context.Context, client *sdk.Seqera, ref string) (int64, error) {
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		if id <= 0 {
+			return 0, fmt.Errorf("workspace ID must be positive, got %d", id)
+		}
+		return id, nil
+	}
+
+	orgName, workspaceName, ok := strings.Cut(ref, "/")
+	if !ok || orgName == "" || workspaceName == "" || strings.Contains(workspaceName, "/") {
+		return 0, fmt.Errorf("expected a numeric workspace ID or an \"org/workspace\" full name, got %q", ref)
+	}
+
+	orgsRes, err := client.Orgs.ListOrganizations(ctx, operations.ListOrganizationsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("listing organizations: %w", err)
+	}
+	if orgsRes.StatusCode != 200 || orgsRes.ListOrganizationsResponse == nil {
+		return 0, UnexpectedStatusErr(ctx, "listing organizations", orgsRes.RawResponse)
+	}
+
+	var orgID int64
+	for _, org := range orgsRes.ListOrganizationsResponse.Organizations {
+		if org.Name != nil && *org.Name == orgName && org.OrgID != nil {
+			orgID = *org.OrgID
+			break
+		}
+	}
+	if orgID == 0 {
+		return 0, fmt.Errorf("no organization found with name: %s", orgName)
+	}
+
+	// The ListWorkspaces API has no name filter, so all workspaces of the
+	// organization are fetched and matched locally.
+	workspacesRes, err := client.Workspaces.ListWorkspaces(ctx, operations.ListWorkspacesRequest{
+		OrgID: orgID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("listing workspaces: %w", err)
+	}
+	if workspacesRes.StatusCode != 200 || workspacesRes.ListWorkspacesResponse == nil {
+		return 0, UnexpectedStatusErr(ctx, "listing workspaces", workspacesRes.RawResponse)
+	}
+
+	for _, workspace := range workspacesRes.ListWorkspacesResponse.Workspaces {
+		if workspace.Name != nil && *workspace.Name == workspaceName && workspace.ID != nil {
+			return *workspace.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("no workspace named %q found in organization %q", workspaceName, orgName)
+}
+
+// ApplyDefaultWorkspace plans `workspace_id` from the provider
+// `default_workspace` when the configuration omits it, so the plan shows the
+// resolved ID. requiresReplace mirrors whether the resource replaces itself
+// when `workspace_id` changes. Call it from ModifyPlan.
+func ApplyDefaultWorkspace(ctx context.Context, client *sdk.Seqera, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, requiresReplace bool) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("workspace_id"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	// The provider is not configured yet (for example its configuration
+	// depends on unknown values): leave workspace_id unknown.
+	if client == nil {
+		return
+	}
+
+	defaults := ProviderDefaultsFor(client)
+	if defaults.WorkspaceUnknown {
+		return
+	}
+
+	workspaceID := defaults.WorkspaceID
+	if workspaceID == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("workspace_id"), types.Int64Value(workspaceID))...)
+
+	if !requiresReplace || req.State.Raw.IsNull() {
+		return
+	}
+
+	var current types.Int64
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("workspace_id"), &current)...)
+	if !current.IsNull() && !current.IsUnknown() && current.ValueInt64() != workspaceID {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("workspace_id"))
+	}
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+func TestResolveWorkspaceInvalidReference(t *testing.T) {
+	for _, ref := range []string{"0", "my-org", "my-org/", "/production", "a/b/c"} {
+		if _, err := ResolveWorkspace(context.Background(), nil, ref); err == nil {
+			t.Errorf("expected %q to be rejected before any API call", ref)
+		}
+	}
+
+	if id, err := ResolveWorkspace(context.Background(), nil, "42"); err != nil || id != 42 {
+		t.Errorf("numeric reference: got %d, %v", id, err)
+	}
+}
+
+func TestApplyDefaultWorkspace(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"workspace_id": schema.Int64Attribute{Optional: true, Computed: true},
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"workspace_id": tftypes.Number}}
+	value := func(v any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"workspace_id": tftypes.NewValue(tftypes.Number, v)})
+	}
+
+	client := sdk.New()
+	SetProviderDefaults(client, ProviderDefaults{WorkspaceID: 7})
+
+	for name, tc := range map[string]struct {
+		config, plan, state tftypes.Value
+		want                int64
+		requiresReplace     bool
+	}{
+		"default on create": {
+			config: value(nil),
+			plan:   value(tftypes.UnknownValue),
+			state:  tftypes.NewValue(objectType, nil),
+			want:   7,
+		},
+		"explicit value wins": {
+			config: value(3),
+			plan:   value(3),
+			state:  tftypes.NewValue(objectType, nil),
+			want:   3,
+		},
+		"changed default replaces": {
+			config:          value(nil),
+			plan:            value(tftypes.UnknownValue),
+			state:           value(5),
+			want:            7,
+			requiresReplace: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: tc.config},
+				Plan:   tfsdk.Plan{Schema: s, Raw: tc.plan},
+				State:  tfsdk.State{Schema: s, Raw: tc.state},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			ApplyDefaultWorkspace(ctx, client, req, resp, true)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got int64
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("workspace_id"), &got)...)
+			if got != tc.want {
+				t.Errorf("expected workspace_id %d, got %d", tc.want, got)
+			}
+			if replaced := len(resp.RequiresReplace) > 0; replaced != tc.requiresReplace {
+				t.Errorf("expected requires replace %v, got %v", tc.requiresReplace, replaced)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Redacted replaces sensitive values in diagnostics and logs.
+const Redacted = "(sensitive)"
+
+// sensitiveHeaders are masked in every request and response dump.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveFields are JSON fields holding secrets wherever they appear: the
+// credential keys (in both the camelCase and the snake_case models), and the
+// token values returned by `POST /tokens` and the SCIM token endpoints.
+var sensitiveFields = map[string]bool{
+	"accessKey":     true,
+	"access_key":    true,
+	"access_token":  true,
+	"batchKey":      true,
+	"batch_key":     true,
+	"clientSecret":  true,
+	"client_secret": true,
+	"passphrase":    true,
+	"password":      true,
+	"privateKey":    true,
+	"private_key":   true,
+	"secretKey":     true,
+	"secret_key":    true,
+	"storageKey":    true,
+	"storage_key":   true,
+	"token":         true,
+	"webhookSecret": true,
+}
+
+// sensitivePathFields are JSON fields whose name is too generic to mask
+// everywhere, keyed by the API path segment of the endpoints whose models use
+// them for secrets.
+var sensitivePathFields = map[string][]string{
+	// Google service account key JSON, and the encrypted keys returned by
+	// `GET /credentials/{id}/keys`.
+	"credentials": {"data", "keys"},
+	// Pipeline secret value.
+	"pipeline-secrets": {"value"},
+}
+
+// IsSensitiveHeader reports whether the value of the named HTTP header must
+// not appear in diagnostics or logs.
+func IsSensitiveHeader(name string) bool {
+	for _, h := range sensitiveHeaders {
+		if strings.EqualFold(name, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactHeader returns a copy of header with sensitive values masked.
+func RedactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for name := range redacted {
+		if IsSensitiveHeader(name) {
+			redacted.Set(name, Redacted)
+		}
+	}
+	return redacted
+}
+
+// RedactBody masks the secrets in a JSON request or response body of the
+// API endpoint at urlPath. Bodies that are not JSON are returned unchanged.
+func RedactBody(urlPath string, body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	extra := map[string]bool{}
+	for _, segment := range strings.Split(urlPath, "/") {
+		for _, field := range sensitivePathFields[segment] {
+			extra[field] = true
+		}
+	}
+
+	if !redactValue(value, extra) {
+		return body
+	}
+	var redacted bytes.Buffer
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return body
+	}
+	return bytes.TrimRight(redacted.Bytes(), "\n")
+}
+
+// redactValue masks the sensitive string fields of value in place, and
+// reports whether anything was masked.
+func redactValue(value any, extra map[string]bool) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if s, ok := field.(string); ok && s != "" && (sensitiveFields[key] || extra[key]) {
+				v[key] = Redacted
+				changed = true
+				continue
+			}
+			changed = redactValue(field, extra) || changed
+		}
+	case []any:
+		for _, item := range v {
+			changed = redactValue(item, extra) || changed
+		}
+	}
+	return changed
+}
@@ -0,0 +1,181 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+)
+
+// ServerInfo is what the provider learned about the connected platform from
+// `GET /service-info` at Configure time.
+type ServerInfo struct {
+	// Version is the platform release as reported, such as "v26.1.0".
+	Version string
+
+	// APIVersion is the version of the API itself.
+	APIVersion string
+
+	// Cloud is set for Seqera Platform Cloud, which always runs the latest
+	// release.
+	Cloud bool
+
+	// Features holds the boolean feature flags of the service info, keyed by
+	// their JSON name (for example "waveEnabled"). Flags the server did not
+	// report are absent.
+	Features map[string]bool
+}
+
+// serverInfo maps each configured SDK client to the ServerInfo of the
+// platform it talks to, like providerDefaults.
+var serverInfo sync.Map
+
+// SetServerInfo records the service info of the platform client talks to.
+func SetServerInfo(client *sdk.Seqera, info ServerInfo) {
+	serverInfo.Store(client, info)
+}
+
+// ServerInfoFor returns the service info recorded for client. ok is false
+// when the provider is not configured or the service info was unavailable;
+// version checks are skipped in that case rather than guessed.
+func ServerInfoFor(client *sdk.Seqera) (info ServerInfo, ok bool) {
+	if client == nil {
+		return ServerInfo{}, false
+	}
+	if v, found := serverInfo.Load(client); found {
+		return v.(ServerInfo), true
+	}
+	return ServerInfo{}, false
+}
+
+// NewServerInfo extracts the fields the provider relies on from the API model.
+func NewServerInfo(info *shared.ServiceInfo) ServerInfo {
+	s := ServerInfo{Features: map[string]bool{}}
+	if info == nil {
+		return s
+	}
+	if info.Version != nil {
+		s.Version = *info.Version
+	}
+	if info.APIVersion != nil {
+		s.APIVersion = *info.APIVersion
+	}
+	if info.SeqeraCloud != nil {
+		s.Cloud = *info.SeqeraCloud
+	}
+
+	flags := map[string]*bool{
+		"allowInstanceCredentials": info.AllowInstanceCredentials,
+		"allowNextflowCliLogs":     info.AllowNextflowCliLogs,
+		"groundswellEnabled":       info.GroundswellEnabled,
+		"llmEnabled":               info.LlmEnabled,
+		"seqeraComputeEnabled":     info.SeqeraComputeEnabled,
+		"userWorkspaceEnabled":     info.UserWorkspaceEnabled,
+		"waveEnabled":              info.WaveEnabled,
+	}
+	for name, value := range flags {
+		if value != nil {
+			s.Features[name] = *value
+		}
+	}
+	return s
+}
+
+var versionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// parseVersion reads the leading major.minor[.patch] of a platform version,
+// ignoring build suffixes such as "-cycle3".
+func parseVersion(version string) ([3]int, bool) {
+	var parts [3]int
+	match := versionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return parts, false
+	}
+	for i, group := range match[1:] {
+		if group != "" {
+			parts[i], _ = strconv.Atoi(group)
+		}
+	}
+	return parts, true
+}
+
+// AtLeast reports whether the platform runs minimum (such as "26.1") or a
+// later release. Cloud is always current. ok is false when either version
+// cannot be parsed.
+func (s ServerInfo) AtLeast(minimum string) (supported bool, ok bool) {
+	if s.Cloud {
+		return true, true
+	}
+	have, ok := parseVersion(s.Version)
+	if !ok {
+		return false, false
+	}
+	want, ok := parseVersion(minimum)
+	if !ok {
+		return false, false
+	}
+	for i := range have {
+		if have[i] != want[i] {
+			return have[i] > want[i], true
+		}
+	}
+	return true, true
+}
+
+// RequireServerVersion adds a plan-time error when the connected Seqera
+// Enterprise is older than minimum. what names the resource or attribute
+// needing it; attrPath locates the error, or is empty for a whole resource.
+// Nothing is reported when the version is not known.
+func RequireServerVersion(client *sdk.Seqera, minimum, what string, attrPath path.Path, diags *diag.Diagnostics) {
+	info, ok := ServerInfoFor(client)
+	if !ok {
+		return
+	}
+	supported, ok := info.AtLeast(minimum)
+	if !ok || supported {
+		return
+	}
+
+	summary := "Unsupported Seqera Platform Version"
+	detail := fmt.Sprintf("%s requires Seqera Enterprise v%s or later, but the connected platform runs %s. Upgrade the platform, or remove it from the configuration.", what, minimum, info.Version)
+	if attrPath.Equal(path.Empty()) {
+		diags.AddError(summary, detail)
+		return
+	}
+	diags.AddAttributeError(attrPath, summary, detail)
+}
+
+// RequireServerVersionIfSet is RequireServerVersion for an optional
+// attribute: it only applies when the attribute at attrPath is set in config.
+func RequireServerVersionIfSet(ctx context.Context, client *sdk.Seqera, config tfsdk.Config, attrPath path.Path, minimum string, diags *diag.Diagnostics) {
+	var value attr.Value
+	if d := config.GetAttribute(ctx, attrPath, &value); d.HasError() || value == nil || value.IsNull() {
+		return
+	}
+	RequireServerVersion(client, minimum, fmt.Sprintf("The %s argument", attrPath), attrPath, diags)
+}
+
+// RequireServerFeature adds a plan-time error when the platform reports the
+// feature flag (a ServerInfo.Features key) as disabled. Nothing is reported
+// when the flag is unknown.
+func RequireServerFeature(client *sdk.Seqera, feature, what string, diags *diag.Diagnostics) {
+	info, ok := ServerInfoFor(client)
+	if !ok {
+		return
+	}
+	if enabled, reported := info.Features[feature]; reported && !enabled {
+		diags.AddError(
+			"Unsupported Seqera Platform Feature",
+			fmt.Sprintf("%s requires the %q feature, which the connected platform reports as disabled.", what, feature),
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+func TestServerInfoAtLeast(t *testing.T) {
+	for _, tc := range []struct {
+		version, minimum string
+		supported, ok    bool
+	}{
+		{"v25.3.0", "25.3", true, true},
+		{"v25.2.4", "25.3", false, true},
+		{"26.1.0-cycle3_abcdef", "25.3", true, true},
+		{"v25.3", "25.3.1", false, true},
+		{"", "25.3", false, false},
+		{"dev", "25.3", false, false},
+	} {
+		supported, ok := ServerInfo{Version: tc.version}.AtLeast(tc.minimum)
+		if supported != tc.supported || ok != tc.ok {
+			t.Errorf("%q >= %q: got (%v, %v), want (%v, %v)", tc.version, tc.minimum, supported, ok, tc.supported, tc.ok)
+		}
+	}
+
+	if supported, _ := (ServerInfo{Cloud: true}).AtLeast("99.0"); !supported {
+		t.Error("expected Cloud to satisfy any minimum")
+	}
+}
+
+func TestRequireServerVersionIfSet(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Optional: true},
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	config := func(v any) tfsdk.Config {
+		return tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, v)})}
+	}
+
+	client := sdk.New()
+	SetServerInfo(client, ServerInfo{Version: "v25.2.0"})
+
+	var diags diag.Diagnostics
+	RequireServerVersionIfSet(ctx, client, config(nil), path.Root("name"), "25.3", &diags)
+	if diags.HasError() {
+		t.Fatalf("expected no error for an unset attribute, got %v", diags)
+	}
+
+	RequireServerVersionIfSet(ctx, client, config("x"), path.Root("name"), "25.3", &diags)
+	if !diags.HasError() {
+		t.Error("expected an error for a set attribute on an older platform")
+	}
+
+	diags = nil
+	RequireServerVersionIfSet(ctx, sdk.New(), config("x"), path.Root("name"), "25.3", &diags)
+	if diags.HasError() {
+		t.Errorf("expected no error when the platform version is unknown, got %v", diags)
+	}
+}
@@ -0,0 +1,156 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+)
+
+// StudioPollInterval is how often WaitForStudio describes a studio.
+const StudioPollInterval = 10 * time.Second
+
+// StudioStatus is the runtime status of a studio.
+type StudioStatus struct {
+	SessionID string
+	Name      string
+	Status    shared.DataStudioStatus
+	Message   string
+}
+
+// DecodeStudio reads the status of a studio from a `GET /studios/{sessionId}`
+// or `PUT /studios/{sessionId}/lifespan` response body. The SDK model of a
+// studio drops `statusInfo` for the `seqera_studios` resource, so the body is
+// decoded here instead. The status is kept as a string so statuses added to
+// the platform later still decode.
+func DecodeStudio(body io.Reader) (StudioStatus, error) {
+	var studio studioBody
+	if err := json.NewDecoder(body).Decode(&studio); err != nil {
+		return StudioStatus{}, fmt.Errorf("decoding studio: %w", err)
+	}
+	return studio.status(), nil
+}
+
+// DecodeStudioList reads a `GET /studios` response body, for the same
+// reason as DecodeStudio.
+func DecodeStudioList(body io.Reader) ([]StudioStatus, int64, error) {
+	var page struct {
+		Studios   []studioBody `json:"studios"`
+		TotalSize int64        `json:"totalSize"`
+	}
+	if err := json.NewDecoder(body).Decode(&page); err != nil {
+		return nil, 0, fmt.Errorf("decoding studios: %w", err)
+	}
+	studios := make([]StudioStatus, 0, len(page.Studios))
+	for _, studio := range page.Studios {
+		studios = append(studios, studio.status())
+	}
+	return studios, page.TotalSize, nil
+}
+
+type studioBody struct {
+	SessionID  string `json:"sessionId"`
+	Name       string `json:"name"`
+	StatusInfo struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"statusInfo"`
+}
+
+func (s studioBody) status() StudioStatus {
+	return StudioStatus{
+		SessionID: s.SessionID,
+		Name:      s.Name,
+		Status:    shared.DataStudioStatus(s.StatusInfo.Status),
+		Message:   s.StatusInfo.Message,
+	}
+}
+
+// WaitForStudio polls a studio every interval until it reaches target, which
+// is either running or stopped, reporting each status change through
+// progress. It returns the final status, with an error when the studio
+// settles in another status or ctx is done first.
+//
+// The platform applies start and stop requests asynchronously, so the first
+// descriptions may still show the status the studio had before the request.
+// Settling in another status is only an error once the status has changed,
+// unless submitted is false: the platform then rejected the request, and a
+// studio that is already errored or failed to build is not going to change.
+func WaitForStudio(ctx context.Context, client *sdk.Seqera, workspaceID int64, sessionID string, target shared.DataStudioStatus, submitted bool, interval time.Duration, progress func(string)) (StudioStatus, error) {
+	var last StudioStatus
+	changed := false
+	for {
+		res, err := client.Studios.DescribeDataStudio(ctx, operations.DescribeDataStudioRequest{
+			SessionID:   sessionID,
+			WorkspaceID: &workspaceID,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, studioWaitTimeoutErr(ctx, sessionID, target, last.Status)
+			}
+			return last, fmt.Errorf("describing studio %s: %w", sessionID, err)
+		}
+		if res.StatusCode != http.StatusOK || res.RawResponse == nil {
+			return last, UnexpectedStatusErr(ctx, "describing studio "+sessionID, res.RawResponse)
+		}
+		studio, err := DecodeStudio(res.RawResponse.Body)
+		if err != nil {
+			return last, err
+		}
+
+		if studio.Status != last.Status {
+			progress(fmt.Sprintf("Studio %s is %s", sessionID, studio.Status))
+			changed = changed || last.Status != ""
+		}
+		last = studio
+
+		if studio.Status == target {
+			return studio, nil
+		}
+		if (changed && studioSettled(studio.Status)) || (!submitted && studioFailed(studio.Status)) {
+			detail := ""
+			if studio.Message != "" {
+				detail = ": " + studio.Message
+			}
+			return studio, fmt.Errorf("studio %s is %s instead of %s%s", sessionID, studio.Status, target, detail)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, studioWaitTimeoutErr(ctx, sessionID, target, last.Status)
+		case <-timer.C:
+		}
+	}
+}
+
+// studioSettled reports whether status only changes on a user request.
+func studioSettled(status shared.DataStudioStatus) bool {
+	switch status {
+	case shared.DataStudioStatusRunning, shared.DataStudioStatusStopped,
+		shared.DataStudioStatusErrored, shared.DataStudioStatusBuildFailed:
+		return true
+	}
+	return false
+}
+
+// studioFailed reports whether status is a failure that only a new start
+// request clears.
+func studioFailed(status shared.DataStudioStatus) bool {
+	return status == shared.DataStudioStatusErrored || status == shared.DataStudioStatusBuildFailed
+}
+
+func studioWaitTimeoutErr(ctx context.Context, sessionID string, target, last shared.DataStudioStatus) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for studio %s to be %s, last seen %s", sessionID, target, last)
+	}
+	return fmt.Errorf("stopped waiting for studio %s to be %s, last seen %s: %w", sessionID, target, last, ctx.Err())
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+)
+
+func TestWaitForStudio(t *testing.T) {
+	for name, tc := range map[string]struct {
+		statuses []string
+		target   shared.DataStudioStatus
+		rejected bool
+		wantErr  string
+	}{
+		"started":      {statuses: []string{"starting", "running"}, target: shared.DataStudioStatusRunning},
+		"stopped":      {statuses: []string{"running", "stopping", "stopped"}, target: shared.DataStudioStatusStopped},
+		"errored":      {statuses: []string{"starting", "errored"}, target: shared.DataStudioStatusRunning, wantErr: "is errored instead of running: out of memory"},
+		"new status":   {statuses: []string{"queued", "running"}, target: shared.DataStudioStatusRunning},
+		"not applied":  {statuses: []string{"stopped"}, target: shared.DataStudioStatusRunning, wantErr: "timed out waiting for studio a1b2 to be running, last seen stopped"},
+		"start failed": {statuses: []string{"stopped", "starting", "stopped"}, target: shared.DataStudioStatusRunning, wantErr: "is stopped instead of running"},
+		"timeout":      {statuses: []string{"stopping"}, target: shared.DataStudioStatusStopped, wantErr: "timed out"},
+		"restarted":    {statuses: []string{"errored", "starting", "running"}, target: shared.DataStudioStatusRunning},
+		"rejected":     {statuses: []string{"buildFailed"}, target: shared.DataStudioStatusRunning, rejected: true, wantErr: "is buildFailed instead of running"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(calls.Add(1)) - 1
+				status := tc.statuses[min(n, len(tc.statuses)-1)]
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"sessionId":  "a1b2",
+					"name":       "training",
+					"statusInfo": map[string]any{"status": status, "message": "out of memory"},
+				})
+			}))
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			var progress []string
+			studio, err := WaitForStudio(ctx, sdk.New(sdk.WithServerURL(server.URL)), 1, "a1b2", tc.target, !tc.rejected, 10*time.Millisecond, func(msg string) {
+				progress = append(progress, msg)
+			})
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if studio.Status != tc.target || studio.Name != "training" {
+					t.Errorf("expected studio training to be %s, got %+v", tc.target, studio)
+				}
+				if len(progress) != len(tc.statuses) {
+					t.Errorf("expected one progress message per status, got %v", progress)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -2,32 +2,75 @@
 package common
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httputil"
 )
 
-// DebugResponse formats an HTTP response for error messages.
+// DebugResponse formats an HTTP response for error messages. Credentials in
+// headers and secrets in the request and response bodies are redacted.
 func DebugResponse(response *http.Response) string {
-	if v := response.Request.Header.Get("Authorization"); v != "" {
-		response.Request.Header.Set("Authorization", "(sensitive)")
+	dumpReq, err := dumpRequest(response.Request)
+	if err != nil {
+		return err.Error()
 	}
-	dumpReq, err := httputil.DumpRequest(response.Request, true)
+	dumpRes, err := dumpResponse(response, response.Request.URL.Path)
 	if err != nil {
-		dumpReq, err = httputil.DumpRequest(response.Request, false)
-		if err != nil {
-			return err.Error()
-		}
+		return err.Error()
+	}
+	return fmt.Sprintf("**Request**:\n%s\n**Response**:\n%s", string(dumpReq), string(dumpRes))
+}
+
+// dumpRequest dumps the headers of a sent request, then its redacted body,
+// which is read through GetBody because the transport consumed Body.
+func dumpRequest(req *http.Request) ([]byte, error) {
+	clone := req.Clone(req.Context())
+	clone.Header = RedactHeader(req.Header)
+	clone.Body = nil
+	dump, err := httputil.DumpRequest(clone, false)
+	if err != nil {
+		return nil, err
 	}
-	dumpRes, err := httputil.DumpResponse(response, true)
+	if req.GetBody == nil {
+		return dump, nil
+	}
+	body, err := req.GetBody()
 	if err != nil {
-		dumpRes, err = httputil.DumpResponse(response, false)
+		return dump, nil
+	}
+	defer body.Close()
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return dump, nil
+	}
+	return append(dump, RedactBody(req.URL.Path, content)...), nil
+}
+
+// dumpResponse dumps the headers and redacted body of a response, leaving
+// the body readable for the caller.
+func dumpResponse(res *http.Response, urlPath string) ([]byte, error) {
+	var content []byte
+	if res.Body != nil {
+		var err error
+		content, err = io.ReadAll(res.Body)
+		res.Body.Close()
+		res.Body = io.NopCloser(bytes.NewReader(content))
 		if err != nil {
-			return err.Error()
+			return nil, err
 		}
 	}
-	return fmt.Sprintf("**Request**:\n%s\n**Response**:\n%s", string(dumpReq), string(dumpRes))
+
+	clone := *res
+	clone.Header = RedactHeader(res.Header)
+	clone.Body = io.NopCloser(bytes.NewReader(nil))
+	dump, err := httputil.DumpResponse(&clone, false)
+	if err != nil {
+		return nil, err
+	}
+	return append(dump, RedactBody(urlPath, content)...), nil
 }
 
 // PaginatedSearch performs a paginated search through API results.
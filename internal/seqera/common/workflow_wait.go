@@ -0,0 +1,88 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+)
+
+// WorkflowPollInterval is how often WaitForWorkflow describes a running
+// workflow.
+const WorkflowPollInterval = 30 * time.Second
+
+// workflowUnknownPolls is how many descriptions in a row must report
+// UNKNOWN before WaitForWorkflow gives up on the workflow.
+const workflowUnknownPolls = 3
+
+// WaitForWorkflow polls a launched workflow every interval until it reaches
+// a terminal status, reporting each status change through progress. It
+// returns nil once the workflow succeeded, and an error when it failed, was
+// cancelled, or ctx is done first; the workflow keeps running on the
+// platform in that last case.
+//
+// Right after a launch the platform may report no status, or UNKNOWN, for a
+// healthy workflow. A missing status keeps the wait going, and UNKNOWN only
+// fails it once it persists for workflowUnknownPolls descriptions.
+func WaitForWorkflow(ctx context.Context, client *sdk.Seqera, workspaceID int64, workflowID string, interval time.Duration, progress func(string)) error {
+	var last shared.WorkflowStatus
+	unknown := 0
+	for {
+		res, err := client.Workflows.DescribeWorkflow(ctx, operations.DescribeWorkflowRequest{
+			WorkflowID:  workflowID,
+			WorkspaceID: &workspaceID,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return waitTimeoutErr(ctx, workflowID, last)
+			}
+			return fmt.Errorf("describing workflow %s: %w", workflowID, err)
+		}
+		if res.StatusCode != http.StatusOK || res.DescribeWorkflowResponse == nil || res.DescribeWorkflowResponse.Workflow == nil {
+			return UnexpectedStatusErr(ctx, "describing workflow "+workflowID, res.RawResponse)
+		}
+
+		var status shared.WorkflowStatus
+		if s := res.DescribeWorkflowResponse.Workflow.Status; s != nil {
+			status = *s
+		}
+		if status != "" && status != last {
+			progress(fmt.Sprintf("Workflow %s is %s", workflowID, status))
+			last = status
+		}
+
+		switch status {
+		case shared.WorkflowStatusSucceeded:
+			return nil
+		case shared.WorkflowStatusFailed, shared.WorkflowStatusCancelled:
+			return fmt.Errorf("workflow %s finished with status %s", workflowID, status)
+		case shared.WorkflowStatusUnknown:
+			unknown++
+			if unknown >= workflowUnknownPolls {
+				return fmt.Errorf("workflow %s status has been %s for %d checks", workflowID, status, unknown)
+			}
+		default:
+			unknown = 0
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return waitTimeoutErr(ctx, workflowID, last)
+		case <-timer.C:
+		}
+	}
+}
+
+func waitTimeoutErr(ctx context.Context, workflowID string, last shared.WorkflowStatus) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for workflow %s, last seen %s; the workflow keeps running on the platform", workflowID, last)
+	}
+	return fmt.Errorf("stopped waiting for workflow %s, last seen %s: %w", workflowID, last, ctx.Err())
+}
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+func TestWaitForWorkflow(t *testing.T) {
+	for name, tc := range map[string]struct {
+		statuses []string
+		wantErr  string
+	}{
+		"succeeded": {statuses: []string{"SUBMITTED", "RUNNING", "SUCCEEDED"}},
+		"failed":    {statuses: []string{"RUNNING", "FAILED"}, wantErr: "finished with status FAILED"},
+		"timeout":   {statuses: []string{"RUNNING"}, wantErr: "timed out"},
+		// An empty status is left out of the response.
+		"no status yet":   {statuses: []string{"", "", "RUNNING", "SUCCEEDED"}},
+		"unknown briefly": {statuses: []string{"UNKNOWN", "UNKNOWN", "RUNNING", "SUCCEEDED"}},
+		"unknown":         {statuses: []string{"RUNNING", "UNKNOWN"}, wantErr: "status has been UNKNOWN for 3 checks"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(calls.Add(1)) - 1
+				status := tc.statuses[min(n, len(tc.statuses)-1)]
+				workflow := map[string]any{"id": "4Bx"}
+				if status != "" {
+					workflow["status"] = status
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]any{"workflow": workflow})
+			}))
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			var progress []string
+			err := WaitForWorkflow(ctx, sdk.New(sdk.WithServerURL(server.URL)), 1, "4Bx", 10*time.Millisecond, func(msg string) {
+				progress = append(progress, msg)
+			})
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := distinctStatuses(tc.statuses); len(progress) != want {
+					t.Errorf("expected %d progress messages, one per status change, got %v", want, progress)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func distinctStatuses(statuses []string) int {
+	n, last := 0, ""
+	for _, status := range statuses {
+		if status != "" && status != last {
+			n++
+			last = status
+		}
+	}
+	return n
+}
@@ -12,7 +12,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,7 +29,13 @@ import (
 
 const disabledStatus = "DISABLED"
 
-var _ resource.Resource = &Resource{}
+var (
+	_ resource.Resource               = &Resource{}
+	_ resource.ResourceWithModifyPlan = &Resource{}
+	_ resource.ResourceWithIdentity   = &Resource{}
+)
+
+var identityAttributes = common.WorkspaceIdentity("compute_env_id")
 
 func NewResource() resource.Resource {
 	return &Resource{}
@@ -77,10 +85,11 @@ resource "seqera_compute_env_enabled" "freeze_prod" {
 				},
 			},
 			"workspace_id": schema.Int64Attribute{
-				Required:    true,
-				Description: `Workspace numeric identifier.`,
+				Computed:    true,
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
 				},
 				Validators: []validator.Int64{
 					int64validator.AtLeast(1),
@@ -98,6 +107,13 @@ resource "seqera_compute_env_enabled" "freeze_prod" {
 	}
 }
 
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       `Compute environment string identifier.`,
+	})
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, diags := common.ConfigureClient(req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -106,6 +122,16 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 }
 
+// ModifyPlan fills workspace_id from the provider default_workspace when it
+// is omitted. The enable and disable endpoints were introduced in Seqera
+// Enterprise v26.1.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		common.RequireServerVersion(r.client, "26.1", "seqera_compute_env_enabled", path.Empty(), &resp.Diagnostics)
+	}
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+}
+
 func (r *Resource) apply(ctx context.Context, data ResourceModel) error {
 	ceID := data.ComputeEnvID.ValueString()
 	workspaceID := data.WorkspaceID.ValueInt64()
@@ -141,7 +167,7 @@ func (r *Resource) apply(ctx context.Context, data ResourceModel) error {
 		verb = "enabling"
 	}
 	if statusCode != http.StatusNoContent {
-		return common.UnexpectedStatusErr(verb+" compute env", raw)
+		return common.UnexpectedStatusErr(ctx, verb+" compute env", raw)
 	}
 	return nil
 }
@@ -162,7 +188,7 @@ func (r *Resource) refresh(ctx context.Context, data *ResourceModel) (bool, erro
 		return false, nil
 	}
 	if res.StatusCode != http.StatusOK || res.DescribeComputeEnvResponse == nil || res.DescribeComputeEnvResponse.ComputeEnv == nil {
-		return false, common.UnexpectedStatusErr("describing compute env", res.RawResponse)
+		return false, common.UnexpectedStatusErr(ctx, "describing compute env", res.RawResponse)
 	}
 	ce := res.DescribeComputeEnvResponse.ComputeEnv
 	if ce.Status == nil {
@@ -176,6 +202,8 @@ func (r *Resource) refresh(ctx context.Context, data *ResourceModel) (bool, erro
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -193,6 +221,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -211,6 +241,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var plan, state ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
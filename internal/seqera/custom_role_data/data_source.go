@@ -96,7 +96,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 	if res.StatusCode != 200 {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "describing role", res.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "describing role", res.RawResponse, req.Config.Raw.Type())
 		return
 	}
 	if res.DescribeRoleResponse == nil || res.DescribeRoleResponse.Role == nil {
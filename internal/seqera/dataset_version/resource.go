@@ -9,10 +9,10 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -30,8 +30,13 @@ import (
 var (
 	_ resource.Resource                = &Resource{}
 	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
 )
 
+// identityAttributes identifies a version by workspace, dataset and number.
+var identityAttributes = common.IdentityAttributes{"workspace_id": "workspace_id", "dataset_id": "dataset_id", "version": "version"}
+
 func NewResource() resource.Resource {
 	return &Resource{}
 }
@@ -70,11 +75,12 @@ Import format: workspace_id/dataset_id/version (e.g., "12345/my-dataset/1")
 `,
 		Attributes: map[string]schema.Attribute{
 			"workspace_id": schema.Int64Attribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
 				},
-				Description: `Workspace numeric identifier.`,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
 			},
 			"dataset_id": schema.StringAttribute{
 				Required: true,
@@ -131,6 +137,25 @@ Import format: workspace_id/dataset_id/version (e.g., "12345/my-dataset/1")
 	}
 }
 
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.Int64Attribute{
+				OptionalForImport: true,
+				Description:       `Workspace numeric identifier. Defaults to the provider default_workspace on import.`,
+			},
+			"dataset_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       `Dataset string identifier.`,
+			},
+			"version": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Version number.`,
+			},
+		},
+	}
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, diags := common.ConfigureClient(req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -139,7 +164,15 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 }
 
+// ModifyPlan fills workspace_id from the provider default_workspace when it
+// is omitted.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+}
+
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -178,7 +211,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 	if uploadRes.StatusCode != 200 || uploadRes.UploadDatasetVersionResponse == nil || uploadRes.UploadDatasetVersionResponse.Version == nil {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "uploading dataset version", uploadRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "uploading dataset version", uploadRes.RawResponse, req.Plan.Raw.Type())
 		return
 	}
 
@@ -187,6 +220,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -209,6 +244,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// All configurable attributes require replacement, so this should never be called
 	resp.Diagnostics.AddError("Update Not Supported", "Dataset version resources cannot be updated in place.")
 }
@@ -231,42 +268,27 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 	if disableRes.StatusCode != 204 && disableRes.StatusCode != 404 {
-		resp.Diagnostics.AddError("Failed to disable dataset version", common.DebugResponse(disableRes.RawResponse))
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "disabling dataset version", disableRes.RawResponse, req.State.Raw.Type())
 	}
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: workspace_id/dataset_id/version
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected format: workspace_id/dataset_id/version, got: %s", req.ID),
-		)
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
 		return
 	}
 
-	workspaceID, err := strconv.ParseInt(parts[0], 10, 64)
+	// Import format: workspace_id/dataset_id/version
+	attributes, err := common.ParseImportID("seqera_dataset_version", req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid workspace_id",
-			fmt.Sprintf("workspace_id must be a number, got: %s", parts[0]),
-		)
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
-
-	version, err := strconv.ParseInt(parts[2], 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid version",
-			fmt.Sprintf("version must be a number, got: %s", parts[2]),
-		)
-		return
+	// ParseImportID has checked that workspace_id and version are numbers.
+	for _, name := range []string{"workspace_id", "version"} {
+		value, _ := strconv.ParseInt(attributes[name], 10, 64)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
 	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), version)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), attributes["dataset_id"])...)
 	// file_path and file_hash cannot be recovered from import - user must set file_path in config
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_path"), types.StringNull())...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_hash"), types.StringNull())...)
@@ -282,7 +304,7 @@ func (r *Resource) findVersion(ctx context.Context, workspaceID int64, datasetID
 		return nil, err
 	}
 	if listRes.StatusCode != 200 {
-		return nil, common.UnexpectedStatusErr("listing dataset versions", listRes.RawResponse)
+		return nil, common.UnexpectedStatusErr(ctx, "listing dataset versions", listRes.RawResponse)
 	}
 	if listRes.ListDatasetVersionsResponse == nil {
 		return nil, fmt.Errorf("empty response listing dataset versions")
@@ -0,0 +1,138 @@
+// Package encrypted_credentials provides the seqera_encrypted_credentials
+// ephemeral resource, which reads the encrypted keys of workspace credentials
+// without storing them in state.
+package encrypted_credentials
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralResource{}
+)
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{}
+}
+
+type EphemeralResource struct {
+	client *sdk.Seqera
+}
+
+type EphemeralResourceModel struct {
+	WorkspaceID   types.Int64  `tfsdk:"workspace_id"`
+	CredentialsID types.String `tfsdk:"credentials_id"`
+	PairingID     types.String `tfsdk:"pairing_id"`
+	Keys          types.String `tfsdk:"keys"`
+}
+
+func (r *EphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_encrypted_credentials"
+}
+
+func (r *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the encrypted keys of workspace credentials, for example to bootstrap a Tower agent, without storing them in state or plan files.
+
+Wraps ` + "`GET /credentials/{credentialsId}/keys`" + `. The keys are encrypted with the key identified by ` + "`pairing_id`" + `, so only its holder can decrypt them.
+
+` + "```hcl" + `
+ephemeral "seqera_encrypted_credentials" "agent" {
+  credentials_id = seqera_tower_agent_credential.hpc.credentials_id
+  pairing_id     = seqera_tower_agent_credential.hpc.connection_id
+}
+
+resource "aws_secretsmanager_secret_version" "agent_keys" {
+  secret_id                = aws_secretsmanager_secret.agent_keys.id
+  secret_string_wo         = ephemeral.seqera_encrypted_credentials.agent.keys
+  secret_string_wo_version = 1
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"credentials_id": schema.StringAttribute{
+				Required:    true,
+				Description: `Credentials string identifier.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"pairing_id": schema.StringAttribute{
+				Optional:    true,
+				Description: `Identifier of the key to encrypt the credentials with, such as the connection ID of a Tower agent.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"keys": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: `Encrypted credentials keys.`,
+			},
+		},
+	}
+}
+
+func (r *EphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+func (r *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(r.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	res, err := r.client.Credentials.GetEncryptedCredentials(ctx, operations.GetEncryptedCredentialsRequest{
+		CredentialsID: data.CredentialsID.ValueString(),
+		WorkspaceID:   &workspaceID,
+		PairingID:     data.PairingID.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read encrypted credentials", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.GetCredentialsKeysResponse == nil || res.GetCredentialsKeysResponse.Keys == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "reading encrypted credentials", res.RawResponse, req.Config.Raw.Type())
+		return
+	}
+
+	data.WorkspaceID = types.Int64Value(workspaceID)
+	data.Keys = types.StringValue(*res.GetCredentialsKeysResponse.Keys)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
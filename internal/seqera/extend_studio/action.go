@@ -0,0 +1,139 @@
+// Package extend_studio provides the seqera_extend_studio action, which
+// extends the lifespan of a running studio.
+package extend_studio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	client *sdk.Seqera
+}
+
+type ActionModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	SessionID   types.String `tfsdk:"session_id"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+}
+
+func (a *Action) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extend_studio"
+}
+
+func (a *Action) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Extend the lifespan of a running Seqera studio, so it is not stopped when its ` + "`lifespan_hours`" + ` run out, and check that it is still running.
+
+Wraps ` + "`PUT /studios/{sessionId}/lifespan`" + `. The ` + "`seqera_studios`" + ` resource is left untouched, so extending a studio this way plans no replacement.
+
+` + "```hcl" + `
+action "seqera_extend_studio" "training" {
+  config {
+    session_id = seqera_studios.training.session_id
+  }
+}
+` + "```" + `
+
+The action fails when the studio is not running within ` + "`wait_timeout`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"session_id": schema.StringAttribute{
+				Required:    true,
+				Description: `Studio session identifier.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"wait_timeout": common.StudioWaitTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *Action) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		a.client = client
+	}
+}
+
+func (a *Action) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(a.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	sessionID := data.SessionID.ValueString()
+	res, err := a.client.Studios.ExtendDataStudioLifespan(ctx, operations.ExtendDataStudioLifespanRequest{
+		SessionID:   sessionID,
+		WorkspaceID: &workspaceID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to extend studio lifespan", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.DataStudioDto == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "extending studio lifespan", res.RawResponse, req.Config.Raw.Type())
+		return
+	}
+	common.SendProgress(resp, fmt.Sprintf("Extended lifespan of studio %s in workspace %d", sessionID, workspaceID))
+
+	// Unlike after a start, a studio that is not running here will not get
+	// there by itself.
+	studio, err := common.DecodeStudio(res.RawResponse.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to extend studio lifespan", err.Error())
+		return
+	}
+	if studio.Status == shared.DataStudioStatusStopped || studio.Status == shared.DataStudioStatusErrored {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("session_id"),
+			"Studio Not Running",
+			fmt.Sprintf("Studio %s is %s; only a running studio has a lifespan to extend.", sessionID, studio.Status),
+		)
+		return
+	}
+
+	common.WaitForStudioStatus(ctx, a.client, resp, workspaceID, sessionID, shared.DataStudioStatusRunning, true, data.WaitTimeout)
+}
@@ -0,0 +1,80 @@
+// Package format_import_id provides the provider::seqera::format_import_id
+// function, building a resource import ID from attribute values.
+package format_import_id
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var _ function.Function = &Function{}
+
+func NewFunction() function.Function {
+	return &Function{}
+}
+
+type Function struct{}
+
+func (f *Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_import_id"
+}
+
+func (f *Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a resource import ID from attribute values",
+		MarkdownDescription: `Build the ` + "`terraform import`" + ` ID of a resource from attribute values keyed by resource attribute, the inverse of ` + "`parse_import_id`" + `. Null values are ignored, so optional parts such as a label ` + "`value`" + ` can be passed through unconditionally:
+
+` + "```hcl" + `
+import {
+  to = seqera_pipeline.rnaseq
+  id = provider::seqera::format_import_id("seqera_pipeline", {
+    workspace_id = var.workspace_id
+    pipeline_id  = var.pipeline_id
+  })
+}
+` + "```",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: `Resource type, such as "seqera_pipeline".`,
+			},
+			function.MapParameter{
+				Name:        "attributes",
+				ElementType: types.StringType,
+				Description: `Attribute values making up the import ID, keyed by resource attribute.`,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType string
+	var values map[string]types.String
+	resp.Error = req.Arguments.Get(ctx, &resourceType, &values)
+	if resp.Error != nil {
+		return
+	}
+
+	attributes := make(map[string]string, len(values))
+	for name, value := range values {
+		if !value.IsNull() {
+			attributes[name] = value.ValueString()
+		}
+	}
+	id, err := common.FormatImportID(resourceType, attributes)
+	if errors.Is(err, common.ErrNoImportID) {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, id)
+}
@@ -0,0 +1,129 @@
+// Package launch_action provides the seqera_launch_action action, which
+// triggers a Seqera pipeline action (seqera_action resource) the way its
+// webhook does, with the launch configuration saved in the action.
+package launch_action
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	client *sdk.Seqera
+}
+
+type ActionModel struct {
+	WorkspaceID       types.Int64  `tfsdk:"workspace_id"`
+	ActionID          types.String `tfsdk:"action_id"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	WaitTimeout       types.String `tfsdk:"wait_timeout"`
+}
+
+func (a *Action) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_launch_action"
+}
+
+func (a *Action) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Trigger a Seqera pipeline action, launching a workflow with the launch configuration saved in the action.
+
+Wraps ` + "`POST /actions/{actionId}/launch`" + `, the call behind the action's webhook.
+
+` + "```hcl" + `
+action "seqera_launch_action" "nightly" {
+  config {
+    action_id           = seqera_action.nightly.action_id
+    wait_for_completion = true
+    wait_timeout        = "3h"
+  }
+}
+` + "```" + `
+
+With ` + "`wait_for_completion`" + `, the action fails unless the workflow succeeds. Cancelling the wait, or hitting ` + "`wait_timeout`" + `, leaves the workflow running on the platform.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"action_id": schema.StringAttribute{
+				Required:    true,
+				Description: `Pipeline action string identifier.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, common.WorkflowWaitAttributes())
+}
+
+func (a *Action) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		a.client = client
+	}
+}
+
+func (a *Action) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(a.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	res, err := a.client.Actions.LaunchAction(ctx, operations.LaunchActionRequest{
+		ActionID:            data.ActionID.ValueString(),
+		WorkspaceID:         &workspaceID,
+		LaunchActionRequest: shared.LaunchActionRequest{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to launch action", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.LaunchActionResponse == nil || res.LaunchActionResponse.WorkflowID == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "launching action", res.RawResponse, req.Config.Raw.Type())
+		return
+	}
+	workflowID := *res.LaunchActionResponse.WorkflowID
+	common.SendProgress(resp, fmt.Sprintf("Launched workflow %s in workspace %d", workflowID, workspaceID))
+
+	common.WaitForLaunchedWorkflow(ctx, a.client, resp, workspaceID, workflowID, data.WaitForCompletion, data.WaitTimeout)
+}
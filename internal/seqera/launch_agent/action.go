@@ -0,0 +1,133 @@
+// Package launch_agent provides the seqera_launch_agent action, which
+// launches a workspace AI agent (seqera_agent resource).
+package launch_agent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	client *sdk.Seqera
+}
+
+type ActionModel struct {
+	WorkspaceID  types.Int64  `tfsdk:"workspace_id"`
+	AgentID      types.String `tfsdk:"agent_id"`
+	Instructions types.String `tfsdk:"instructions"`
+}
+
+func (a *Action) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_launch_agent"
+}
+
+func (a *Action) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Launch a Seqera Platform workspace agent.
+
+Wraps ` + "`POST /agents/launch`" + `. The agent runs on the platform with its saved
+instructions; ` + "`instructions`" + ` adds a request for this run only.
+
+` + "```hcl" + `
+action "seqera_launch_agent" "triage" {
+  config {
+    agent_id     = seqera_agent.triage.agent_id
+    instructions = "Summarise the failed runs of the last 24 hours."
+  }
+}
+` + "```" + `
+
+The action returns once the platform has accepted the launch; it does not wait for the agent to finish.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"agent_id": schema.StringAttribute{
+				Required:    true,
+				Description: `Agent string identifier.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"instructions": schema.StringAttribute{
+				Optional:    true,
+				Description: `Instructions for this run, in addition to the agent's saved instructions.`,
+			},
+		},
+	}
+}
+
+func (a *Action) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		a.client = client
+	}
+}
+
+func (a *Action) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(a.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	res, err := a.client.Agents.LaunchAgent(ctx, operations.LaunchAgentRequest{
+		WorkspaceID: &workspaceID,
+		LaunchAgentRequest: shared.LaunchAgentRequest{
+			AgentConfigID: data.AgentID.ValueStringPointer(),
+			Instructions:  data.Instructions.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to launch agent", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.LaunchAgentResponse == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "launching agent", res.RawResponse, req.Config.Raw.Type())
+		return
+	}
+
+	message := fmt.Sprintf("Launched agent %s in workspace %d", data.AgentID.ValueString(), workspaceID)
+	if status := res.LaunchAgentResponse.Status; status != nil {
+		message += fmt.Sprintf(" (status: %s)", *status)
+	}
+	common.SendProgress(resp, message)
+}
@@ -0,0 +1,327 @@
+// Package launch_pipeline provides the seqera_launch_pipeline action. It
+// launches a workflow without keeping a run object in state, unlike the
+// seqera_workflows resource, so that a run can be triggered from an
+// `action_trigger` lifecycle hook, for example a smoke test after a compute
+// environment or pipeline change.
+package launch_pipeline
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	client *sdk.Seqera
+}
+
+type ActionModel struct {
+	WorkspaceID       types.Int64    `tfsdk:"workspace_id"`
+	PipelineID        types.Int64    `tfsdk:"pipeline_id"`
+	Pipeline          types.String   `tfsdk:"pipeline"`
+	ComputeEnvID      types.String   `tfsdk:"compute_env_id"`
+	WorkDir           types.String   `tfsdk:"work_dir"`
+	Revision          types.String   `tfsdk:"revision"`
+	RunName           types.String   `tfsdk:"run_name"`
+	ParamsText        types.String   `tfsdk:"params_text"`
+	ConfigProfiles    []types.String `tfsdk:"config_profiles"`
+	ConfigText        types.String   `tfsdk:"config_text"`
+	PreRunScript      types.String   `tfsdk:"pre_run_script"`
+	PostRunScript     types.String   `tfsdk:"post_run_script"`
+	LabelIds          []types.Int64  `tfsdk:"label_ids"`
+	StubRun           types.Bool     `tfsdk:"stub_run"`
+	PullLatest        types.Bool     `tfsdk:"pull_latest"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	WaitTimeout       types.String   `tfsdk:"wait_timeout"`
+}
+
+func (a *Action) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_launch_pipeline"
+}
+
+func (a *Action) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Launch a workflow run of a Seqera pipeline.
+
+Wraps ` + "`POST /workflow/launch`" + `. Launch either a pipeline saved in the Launchpad, through ` + "`pipeline_id`" + `, whose launch configuration the other attributes override, or a pipeline repository directly, through ` + "`pipeline`" + `. Unlike the ` + "`seqera_workflows`" + ` resource, the action keeps nothing in state, so it suits runs triggered by changes to other resources:
+
+` + "```hcl" + `
+resource "terraform_data" "smoke_test" {
+  input = seqera_aws_batch_ce.prod.compute_env_id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.seqera_launch_pipeline.smoke_test]
+    }
+  }
+}
+
+action "seqera_launch_pipeline" "smoke_test" {
+  config {
+    pipeline_id         = seqera_pipeline.hello.pipeline_id
+    compute_env_id      = seqera_aws_batch_ce.prod.compute_env_id
+    run_name            = "smoke-test"
+    wait_for_completion = true
+  }
+}
+` + "```" + `
+
+With ` + "`wait_for_completion`" + `, the action fails unless the workflow succeeds. Cancelling the wait, or hitting ` + "`wait_timeout`" + `, leaves the workflow running on the platform.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"pipeline_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Launchpad pipeline numeric identifier. Its launch configuration is the base of the run. Specify either pipeline_id or pipeline but not both.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("pipeline"),
+					}...),
+				},
+			},
+			"pipeline": schema.StringAttribute{
+				Optional:    true,
+				Description: `Pipeline repository URL, such as https://github.com/nextflow-io/hello. Specify either pipeline_id or pipeline but not both.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("compute_env_id")),
+				},
+			},
+			"compute_env_id": schema.StringAttribute{
+				Optional:    true,
+				Description: `Compute environment string identifier. Required with pipeline; defaults to the compute environment of the Launchpad pipeline.`,
+			},
+			"work_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: `Nextflow work directory. Defaults to the work directory of the Launchpad pipeline or of the compute environment.`,
+			},
+			"revision": schema.StringAttribute{
+				Optional:    true,
+				Description: `Git branch, tag or commit to run.`,
+			},
+			"run_name": schema.StringAttribute{
+				Optional:    true,
+				Description: `Custom name of the run. Defaults to a random name.`,
+			},
+			"params_text": schema.StringAttribute{
+				Optional:    true,
+				Description: `Pipeline parameters in JSON or YAML format. Replaces the parameters of the Launchpad pipeline.`,
+			},
+			"config_profiles": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: `Nextflow configuration profiles to enable.`,
+			},
+			"config_text": schema.StringAttribute{
+				Optional:    true,
+				Description: `Additional Nextflow configuration.`,
+			},
+			"pre_run_script": schema.StringAttribute{
+				Optional:    true,
+				Description: `Bash script run before the workflow starts.`,
+			},
+			"post_run_script": schema.StringAttribute{
+				Optional:    true,
+				Description: `Bash script run after the workflow ends.`,
+			},
+			"label_ids": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: `Label numeric identifiers to attach to the run.`,
+			},
+			"stub_run": schema.BoolAttribute{
+				Optional:    true,
+				Description: `Run the pipeline stubs instead of the real processes, a quick check of the setup.`,
+			},
+			"pull_latest": schema.BoolAttribute{
+				Optional:    true,
+				Description: `Pull the latest revision of the pipeline repository.`,
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, common.WorkflowWaitAttributes())
+}
+
+func (a *Action) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		a.client = client
+	}
+}
+
+func (a *Action) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(a.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	launch := shared.WorkflowLaunchRequest{Pipeline: data.Pipeline.ValueString()}
+	if !data.PipelineID.IsNull() {
+		saved, err := a.describeLaunch(ctx, workspaceID, data.PipelineID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("pipeline_id"), "Unable to Read Pipeline Launch Configuration", err.Error())
+			return
+		}
+		launch = fromSavedLaunch(saved)
+	}
+	data.override(&launch)
+
+	res, err := a.client.Workflows.CreateWorkflowLaunch(ctx, operations.CreateWorkflowLaunchRequest{
+		WorkspaceID:                 workspaceID,
+		SubmitWorkflowLaunchRequest: shared.SubmitWorkflowLaunchRequest{Launch: launch},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to launch pipeline", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.SubmitWorkflowLaunchResponse == nil || res.SubmitWorkflowLaunchResponse.WorkflowID == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "launching pipeline", res.RawResponse, req.Config.Raw.Type())
+		return
+	}
+	workflowID := *res.SubmitWorkflowLaunchResponse.WorkflowID
+	common.SendProgress(resp, fmt.Sprintf("Launched workflow %s in workspace %d", workflowID, workspaceID))
+
+	common.WaitForLaunchedWorkflow(ctx, a.client, resp, workspaceID, workflowID, data.WaitForCompletion, data.WaitTimeout)
+}
+
+// describeLaunch returns the launch configuration saved with a Launchpad
+// pipeline.
+func (a *Action) describeLaunch(ctx context.Context, workspaceID, pipelineID int64) (*shared.LaunchDbDto, error) {
+	res, err := a.client.Pipelines.DescribePipelineLaunch(ctx, operations.DescribePipelineLaunchRequest{
+		PipelineID:  pipelineID,
+		WorkspaceID: &workspaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK || res.DescribeLaunchResponse == nil || res.DescribeLaunchResponse.Launch == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "describing pipeline launch", res.RawResponse)
+	}
+	return res.DescribeLaunchResponse.Launch, nil
+}
+
+// fromSavedLaunch copies a Launchpad launch configuration into a launch
+// request, the way the Launchpad's own launch form does.
+func fromSavedLaunch(saved *shared.LaunchDbDto) shared.WorkflowLaunchRequest {
+	launch := shared.WorkflowLaunchRequest{
+		ID:               saved.ID,
+		ConfigProfiles:   saved.ConfigProfiles,
+		ConfigText:       saved.ConfigText,
+		EntryName:        saved.EntryName,
+		HeadJobCpus:      saved.HeadJobCpus,
+		HeadJobMemoryMb:  saved.HeadJobMemoryMb,
+		MainScript:       saved.MainScript,
+		NextflowVersion:  saved.NextflowVersion,
+		OutputDir:        saved.OutputDir,
+		ParamsText:       saved.ParamsText,
+		PipelineSchemaID: saved.PipelineSchemaID,
+		PostRunScript:    saved.PostRunScript,
+		PreRunScript:     saved.PreRunScript,
+		PullLatest:       saved.PullLatest,
+		Revision:         saved.Revision,
+		SchemaName:       saved.SchemaName,
+		StubRun:          saved.StubRun,
+		TowerConfig:      saved.TowerConfig,
+		UserSecrets:      saved.UserSecrets,
+		WorkDir:          saved.WorkDir,
+		WorkspaceSecrets: saved.WorkspaceSecrets,
+	}
+	if saved.Pipeline != nil {
+		launch.Pipeline = *saved.Pipeline
+	}
+	if saved.ComputeEnv != nil {
+		launch.ComputeEnvID = saved.ComputeEnv.ComputeEnvID
+	}
+	if saved.SyntaxParser != nil {
+		launch.SyntaxParser = shared.WorkflowLaunchRequestSyntaxParser(*saved.SyntaxParser).ToPointer()
+	}
+	return launch
+}
+
+// override applies the attributes set in the configuration to launch.
+func (data *ActionModel) override(launch *shared.WorkflowLaunchRequest) {
+	if v := data.ComputeEnvID.ValueStringPointer(); v != nil {
+		launch.ComputeEnvID = v
+	}
+	if v := data.WorkDir.ValueStringPointer(); v != nil {
+		launch.WorkDir = v
+	}
+	if v := data.Revision.ValueStringPointer(); v != nil {
+		launch.Revision = v
+	}
+	if v := data.RunName.ValueStringPointer(); v != nil {
+		launch.RunName = v
+	}
+	if v := data.ParamsText.ValueStringPointer(); v != nil {
+		launch.ParamsText = v
+	}
+	if data.ConfigProfiles != nil {
+		launch.ConfigProfiles = make([]string, 0, len(data.ConfigProfiles))
+		for _, profile := range data.ConfigProfiles {
+			launch.ConfigProfiles = append(launch.ConfigProfiles, profile.ValueString())
+		}
+	}
+	if v := data.ConfigText.ValueStringPointer(); v != nil {
+		launch.ConfigText = v
+	}
+	if v := data.PreRunScript.ValueStringPointer(); v != nil {
+		launch.PreRunScript = v
+	}
+	if v := data.PostRunScript.ValueStringPointer(); v != nil {
+		launch.PostRunScript = v
+	}
+	if data.LabelIds != nil {
+		launch.LabelIds = make([]int64, 0, len(data.LabelIds))
+		for _, labelID := range data.LabelIds {
+			launch.LabelIds = append(launch.LabelIds, labelID.ValueInt64())
+		}
+	}
+	if v := data.StubRun.ValueBoolPointer(); v != nil {
+		launch.StubRun = v
+	}
+	if v := data.PullLatest.ValueBoolPointer(); v != nil {
+		launch.PullLatest = v
+	}
+}
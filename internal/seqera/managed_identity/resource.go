@@ -0,0 +1,470 @@
+// Package managed_identity provides the seqera_managed_identity resource,
+// which manages an organization managed identity: the connection settings of
+// an HPC cluster that members log in to with their own credentials
+// (seqera_managed_identity_credential).
+package managed_identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	sdkerrors "github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/errors"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// identityAttributes identifies a managed identity by organization and ID.
+var identityAttributes = common.OrgIdentity("managed_identity_id")
+
+// Platforms lists the HPC platforms a managed identity can connect to.
+var Platforms = []string{
+	string(shared.CreateManagedIdentityRequestPlatformAltairPlatform),
+	string(shared.CreateManagedIdentityRequestPlatformLsfPlatform),
+	string(shared.CreateManagedIdentityRequestPlatformMoabPlatform),
+	string(shared.CreateManagedIdentityRequestPlatformSlurmPlatform),
+	string(shared.CreateManagedIdentityRequestPlatformUgePlatform),
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *sdk.Seqera
+}
+
+type ResourceModel struct {
+	OrgID                   types.Int64  `tfsdk:"org_id"`
+	ManagedIdentityID       types.Int64  `tfsdk:"managed_identity_id"`
+	Name                    types.String `tfsdk:"name"`
+	Platform                types.String `tfsdk:"platform"`
+	HostName                types.String `tfsdk:"host_name"`
+	Port                    types.Int64  `tfsdk:"port"`
+	HeadQueue               types.String `tfsdk:"head_queue"`
+	ComputeQueue            types.String `tfsdk:"compute_queue"`
+	WorkDir                 types.String `tfsdk:"work_dir"`
+	LaunchDir               types.String `tfsdk:"launch_dir"`
+	MaxQueueSize            types.Int64  `tfsdk:"max_queue_size"`
+	HeadJobOptions          types.String `tfsdk:"head_job_options"`
+	PropagateHeadJobOptions types.Bool   `tfsdk:"propagate_head_job_options"`
+	PreRunScript            types.String `tfsdk:"pre_run_script"`
+	PostRunScript           types.String `tfsdk:"post_run_script"`
+	NextflowConfig          types.String `tfsdk:"nextflow_config"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_identity"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage an organization managed identity for an HPC cluster.
+
+A managed identity holds the connection settings of a Slurm, LSF, Altair PBS,
+Moab or Grid Engine cluster. Organization members are onboarded with a
+` + "`seqera_managed_identity_credential`" + ` each, so that pipelines they launch on
+the cluster run under their own POSIX account rather than a shared one.
+
+Import format: org_id/managed_identity_id (e.g., "12345/67")
+`,
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"managed_identity_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: `Managed identity numeric identifier.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: `Managed identity name.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"platform": schema.StringAttribute{
+				Required:    true,
+				Description: `HPC platform of the cluster. One of: ` + strings.Join(Platforms, ", ") + `. Requires replacement if changed.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(Platforms...),
+				},
+			},
+			"host_name": schema.StringAttribute{
+				Required:    true,
+				Description: `Host name of the cluster login node.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Description: `SSH port of the login node. The platform uses 22 when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"head_queue": schema.StringAttribute{
+				Optional:    true,
+				Description: `Queue the Nextflow head job is submitted to.`,
+			},
+			"compute_queue": schema.StringAttribute{
+				Optional:    true,
+				Description: `Queue the pipeline tasks are submitted to. Defaults to head_queue.`,
+			},
+			"work_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: `Default pipeline work directory on the cluster.`,
+			},
+			"launch_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: `Directory Nextflow is launched from. Defaults to work_dir.`,
+			},
+			"max_queue_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Maximum number of jobs Nextflow submits to the queue at once.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"head_job_options": schema.StringAttribute{
+				Optional:    true,
+				Description: `Scheduler options for the Nextflow head job.`,
+			},
+			"propagate_head_job_options": schema.BoolAttribute{
+				Optional:    true,
+				Description: `Whether head_job_options also apply to the pipeline tasks.`,
+			},
+			"pre_run_script": schema.StringAttribute{
+				Optional:    true,
+				Description: `Script run before Nextflow is launched.`,
+			},
+			"post_run_script": schema.StringAttribute{
+				Optional:    true,
+				Description: `Script run after all Nextflow processes have completed.`,
+			},
+			"nextflow_config": schema.StringAttribute{
+				Optional:    true,
+				Description: `Nextflow configuration added to every run on the cluster.`,
+			},
+		},
+	}
+}
+
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.OrgIdentitySchema(identityschema.Int64Attribute{
+		RequiredForImport: true,
+		Description:       `Managed identity numeric identifier.`,
+	})
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueInt64()
+	platform := shared.CreateManagedIdentityRequestPlatform(data.Platform.ValueString())
+	res, err := r.client.Identities.CreateManagedIdentity(ctx, operations.CreateManagedIdentityRequest{
+		OrgID: &orgID,
+		CreateManagedIdentityRequest: shared.CreateManagedIdentityRequest{
+			Name:     data.Name.ValueStringPointer(),
+			Platform: &platform,
+			Config:   gridConfig(data),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create managed identity", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.CreateManagedIdentityResponse == nil || res.CreateManagedIdentityResponse.ID == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "creating managed identity", res.RawResponse, req.Plan.Raw.Type())
+		return
+	}
+
+	data.ManagedIdentityID = types.Int64Value(*res.CreateManagedIdentityResponse.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The list returns the grid configuration as written, where describe
+	// returns it as a compute environment configuration.
+	id := data.ManagedIdentityID.ValueInt64()
+	identity, err := FindManagedIdentity(ctx, r.client, data.OrgID.ValueInt64(), nil, func(mi *shared.ManagedIdentityDbDtoAbstractGridConfig) bool {
+		return mi.ID != nil && *mi.ID == id
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read managed identity", err.Error())
+		return
+	}
+	if identity == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	refreshFromIdentity(&data, identity)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueInt64()
+	id := data.ManagedIdentityID.ValueInt64()
+	platform := shared.ManagedIdentityDbDtoAbstractGridConfigPlatform(data.Platform.ValueString())
+	res, err := r.client.Identities.UpdateManagedIdentity(ctx, operations.UpdateManagedIdentityRequest{
+		OrgID:             &orgID,
+		ManagedIdentityID: id,
+		UpdateManagedIdentityRequest: shared.UpdateManagedIdentityRequest{
+			ManagedIdentity: &shared.ManagedIdentityDbDtoAbstractGridConfig{
+				ID:       &id,
+				Name:     data.Name.ValueStringPointer(),
+				Platform: &platform,
+				Config:   gridConfig(data),
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update managed identity", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusNoContent {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "updating managed identity", res.RawResponse, req.Plan.Raw.Type())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueInt64()
+	res, err := r.client.Identities.DeleteManagedIdentity(ctx, operations.DeleteManagedIdentityRequest{
+		OrgID:             &orgID,
+		ManagedIdentityID: data.ManagedIdentityID.ValueInt64(),
+	})
+	if err != nil {
+		// DeleteManagedIdentity does not declare 404, so an identity already
+		// gone comes back as an API error.
+		var apiErr *sdkerrors.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete managed identity", err.Error())
+		return
+	}
+	switch res.StatusCode {
+	case http.StatusNoContent:
+	case http.StatusConflict:
+		resp.Diagnostics.AddError("Managed Identity In Use", ConflictDetail("managed identity", res.DeleteManagedCredentialsConflictResponse))
+	default:
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "deleting managed identity", res.RawResponse, req.State.Raw.Type())
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
+		return
+	}
+
+	// Import format: org_id/managed_identity_id
+	attributes, err := common.ParseImportID("seqera_managed_identity", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	// ParseImportID has checked that both are numbers.
+	for _, name := range []string{"org_id", "managed_identity_id"} {
+		value, _ := strconv.ParseInt(attributes[name], 10, 64)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// FindManagedIdentity pages through the managed identities of an
+// organization, optionally filtered by name on the server, and returns the
+// first one match accepts.
+func FindManagedIdentity(ctx context.Context, client *sdk.Seqera, orgID int64, search *string, match func(*shared.ManagedIdentityDbDtoAbstractGridConfig) bool) (*shared.ManagedIdentityDbDtoAbstractGridConfig, error) {
+	return common.PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]shared.ManagedIdentityDbDtoAbstractGridConfig, int64, error) {
+			res, err := client.Identities.ListManagedIdentities(ctx, operations.ListManagedIdentitiesRequest{
+				OrgID:  &orgID,
+				Search: search,
+				Max:    &max,
+				Offset: &offset,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing managed identities: %w", err)
+			}
+			if res.StatusCode != http.StatusOK || res.ListManagedIdentitiesResponse == nil {
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing managed identities", res.RawResponse)
+			}
+			var totalSize int64
+			if res.ListManagedIdentitiesResponse.TotalSize != nil {
+				totalSize = *res.ListManagedIdentitiesResponse.TotalSize
+			}
+			return res.ListManagedIdentitiesResponse.ManagedIdentities, totalSize, nil
+		},
+		match,
+	)
+}
+
+// ConflictDetail describes the objects that keep a managed identity or
+// credential from being deleted.
+func ConflictDetail(kind string, conflict *shared.DeleteManagedCredentialsConflictResponse) string {
+	if conflict == nil || len(conflict.Conflicts) == 0 {
+		return fmt.Sprintf("The %s is still in use.", kind)
+	}
+	var users []string
+	for _, c := range conflict.Conflicts {
+		switch {
+		case c.Type != nil && c.Name != nil:
+			users = append(users, fmt.Sprintf("%s %q", *c.Type, *c.Name))
+		case c.Name != nil:
+			users = append(users, fmt.Sprintf("%q", *c.Name))
+		case c.ID != nil:
+			users = append(users, *c.ID)
+		}
+	}
+	return fmt.Sprintf("The %s is still used by: %s. Remove them first.", kind, strings.Join(users, ", "))
+}
+
+// gridConfig builds the cluster configuration from the ResourceModel.
+func gridConfig(data ResourceModel) *shared.AbstractGridConfig {
+	config := &shared.AbstractGridConfig{
+		Discriminator:           data.Platform.ValueStringPointer(),
+		HostName:                data.HostName.ValueStringPointer(),
+		HeadQueue:               data.HeadQueue.ValueStringPointer(),
+		ComputeQueue:            data.ComputeQueue.ValueStringPointer(),
+		WorkDir:                 data.WorkDir.ValueStringPointer(),
+		LaunchDir:               data.LaunchDir.ValueStringPointer(),
+		HeadJobOptions:          data.HeadJobOptions.ValueStringPointer(),
+		PropagateHeadJobOptions: data.PropagateHeadJobOptions.ValueBoolPointer(),
+		PreRunScript:            data.PreRunScript.ValueStringPointer(),
+		PostRunScript:           data.PostRunScript.ValueStringPointer(),
+		NextflowConfig:          data.NextflowConfig.ValueStringPointer(),
+	}
+	if !data.Port.IsNull() {
+		port := int(data.Port.ValueInt64())
+		config.Port = &port
+	}
+	if !data.MaxQueueSize.IsNull() {
+		size := int(data.MaxQueueSize.ValueInt64())
+		config.MaxQueueSize = &size
+	}
+	return config
+}
+
+// refreshFromIdentity updates the ResourceModel from API response. Optional
+// settings the platform reports empty stay null when they are not set.
+func refreshFromIdentity(data *ResourceModel, identity *shared.ManagedIdentityDbDtoAbstractGridConfig) {
+	data.ManagedIdentityID = types.Int64PointerValue(identity.ID)
+	data.Name = types.StringPointerValue(identity.Name)
+	if identity.Platform != nil {
+		data.Platform = types.StringValue(string(*identity.Platform))
+	}
+
+	config := identity.Config
+	if config == nil {
+		config = &shared.AbstractGridConfig{}
+	}
+	data.HostName = types.StringPointerValue(config.HostName)
+	data.Port = optionalInt(config.Port, data.Port)
+	data.HeadQueue = optionalString(config.HeadQueue, data.HeadQueue)
+	data.ComputeQueue = optionalString(config.ComputeQueue, data.ComputeQueue)
+	data.WorkDir = optionalString(config.WorkDir, data.WorkDir)
+	data.LaunchDir = optionalString(config.LaunchDir, data.LaunchDir)
+	data.MaxQueueSize = optionalInt(config.MaxQueueSize, data.MaxQueueSize)
+	data.HeadJobOptions = optionalString(config.HeadJobOptions, data.HeadJobOptions)
+	data.PropagateHeadJobOptions = optionalBool(config.PropagateHeadJobOptions, data.PropagateHeadJobOptions)
+	data.PreRunScript = optionalString(config.PreRunScript, data.PreRunScript)
+	data.PostRunScript = optionalString(config.PostRunScript, data.PostRunScript)
+	data.NextflowConfig = optionalString(config.NextflowConfig, data.NextflowConfig)
+}
+
+func optionalString(value *string, current types.String) types.String {
+	if (value == nil || *value == "") && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringPointerValue(value)
+}
+
+func optionalInt(value *int, current types.Int64) types.Int64 {
+	if value == nil || (*value == 0 && current.IsNull()) {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+func optionalBool(value *bool, current types.Bool) types.Bool {
+	if value == nil || (!*value && current.IsNull()) {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*value)
+}
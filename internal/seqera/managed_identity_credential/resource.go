@@ -0,0 +1,424 @@
+// Package managed_identity_credential provides the
+// seqera_managed_identity_credential resource, which onboards an
+// organization member to a managed identity with their own SSH key.
+package managed_identity_credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	sdkerrors "github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/errors"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/managed_identity"
+)
+
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// identityAttributes identifies a credential by organization, managed
+// identity and credential ID.
+var identityAttributes = common.IdentityAttributes{
+	"org_id":              "org_id",
+	"managed_identity_id": "managed_identity_id",
+	"id":                  "managed_credentials_id",
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *sdk.Seqera
+}
+
+type ResourceModel struct {
+	OrgID                types.Int64  `tfsdk:"org_id"`
+	ManagedIdentityID    types.Int64  `tfsdk:"managed_identity_id"`
+	ManagedCredentialsID types.Int64  `tfsdk:"managed_credentials_id"`
+	UserID               types.Int64  `tfsdk:"user_id"`
+	Name                 types.String `tfsdk:"name"`
+	PrivateKey           types.String `tfsdk:"private_key"`
+	Passphrase           types.String `tfsdk:"passphrase"`
+	UserName             types.String `tfsdk:"user_name"`
+	FirstName            types.String `tfsdk:"first_name"`
+	LastName             types.String `tfsdk:"last_name"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_identity_credential"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Onboard an organization member to a managed identity.
+
+The credential holds the SSH key the member logs in to the cluster of a
+` + "`seqera_managed_identity`" + ` with, so that the pipelines they launch there run
+under their own account. Set ` + "`user_id`" + ` from the member's
+` + "`seqera_organization_member`" + ` to onboard someone else; organization owners
+can manage the credentials of every member.
+
+The platform does not return the key: ` + "`private_key`" + ` and ` + "`passphrase`" + `
+keep the configured values, and an imported credential updates them on the
+next apply.
+
+Import format: org_id/managed_identity_id/managed_credentials_id (e.g., "12345/67/89")
+`,
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"managed_identity_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Managed identity numeric identifier.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"managed_credentials_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: `Managed credentials numeric identifier.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: `User numeric identifier of the member the credential belongs to. Defaults to the user the provider authenticates as. Requires replacement if changed.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: `Credential name.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: `SSH private key the member logs in to the cluster with.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"passphrase": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: `Passphrase of the SSH private key.`,
+			},
+			"user_name": schema.StringAttribute{
+				Computed:    true,
+				Description: `Platform user name of the member.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				Computed:    true,
+				Description: `First name of the member.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				Computed:    true,
+				Description: `Last name of the member.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Organization numeric identifier.`,
+			},
+			"managed_identity_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Managed identity numeric identifier.`,
+			},
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Managed credentials numeric identifier.`,
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueInt64()
+	provider := shared.CreateManagedCredentialsRequestProviderSSH
+	res, err := r.client.Identities.CreateManagedCredentials(ctx, operations.CreateManagedCredentialsRequest{
+		ManagedIdentityID: data.ManagedIdentityID.ValueInt64(),
+		OrgID:             &orgID,
+		UserID:            knownInt64(data.UserID),
+		CreateManagedCredentialsRequest: shared.CreateManagedCredentialsRequest{
+			Provider:    &provider,
+			Credentials: credentials(data),
+			Metadata:    &shared.ManagedCredentialsMetadataInput{},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create managed identity credential", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.CreateManagedCredentialsResponse == nil ||
+		res.CreateManagedCredentialsResponse.ManagedCredentials == nil || res.CreateManagedCredentialsResponse.ManagedCredentials.ID == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "creating managed identity credential", res.RawResponse, req.Plan.Raw.Type())
+		return
+	}
+	data.ManagedCredentialsID = types.Int64Value(*res.CreateManagedCredentialsResponse.ManagedCredentials.ID)
+
+	// The create response holds only the ID; the member comes from the list.
+	found, err := r.refresh(ctx, &data)
+	if err != nil || !found {
+		if err == nil {
+			err = fmt.Errorf("managed credentials %d not found after create", data.ManagedCredentialsID.ValueInt64())
+		}
+		nullUnknowns(&data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Failed to read managed identity credential", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read managed identity credential", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueInt64()
+	provider := shared.UpdateManagedCredentialsRequestProviderSSH
+	res, err := r.client.Identities.UpdateManagedCredentials(ctx, operations.UpdateManagedCredentialsRequest{
+		ManagedIdentityID:    data.ManagedIdentityID.ValueInt64(),
+		ManagedCredentialsID: data.ManagedCredentialsID.ValueInt64(),
+		OrgID:                &orgID,
+		UpdateManagedCredentialsRequest: shared.UpdateManagedCredentialsRequest{
+			Provider:    &provider,
+			Credentials: credentials(data),
+			Metadata:    &shared.ManagedCredentialsMetadataInput{},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update managed identity credential", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusNoContent {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "updating managed identity credential", res.RawResponse, req.Plan.Raw.Type())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueInt64()
+	res, err := r.client.Identities.DeleteManagedCredentials(ctx, operations.DeleteManagedCredentialsRequest{
+		ManagedIdentityID:    data.ManagedIdentityID.ValueInt64(),
+		ManagedCredentialsID: data.ManagedCredentialsID.ValueInt64(),
+		OrgID:                &orgID,
+	})
+	if err != nil {
+		// DeleteManagedCredentials does not declare 404, so a credential
+		// already gone comes back as an API error.
+		var apiErr *sdkerrors.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete managed identity credential", err.Error())
+		return
+	}
+	switch res.StatusCode {
+	case http.StatusNoContent:
+	case http.StatusConflict:
+		resp.Diagnostics.AddError("Managed Identity Credential In Use",
+			managed_identity.ConflictDetail("managed identity credential", res.DeleteManagedCredentialsConflictResponse))
+	default:
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "deleting managed identity credential", res.RawResponse, req.State.Raw.Type())
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
+		return
+	}
+
+	// Import format: org_id/managed_identity_id/managed_credentials_id
+	attributes, err := common.ParseImportID("seqera_managed_identity_credential", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	// ParseImportID has checked that all three are numbers.
+	for _, name := range []string{"org_id", "managed_identity_id", "managed_credentials_id"} {
+		value, _ := strconv.ParseInt(attributes[name], 10, 64)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// refresh lists the credentials of the managed identity and copies the
+// matching member into data. Returns false if the credential is gone. There
+// is no describe operation for managed credentials.
+func (r *Resource) refresh(ctx context.Context, data *ResourceModel) (bool, error) {
+	orgID := data.OrgID.ValueInt64()
+	managedIdentityID := data.ManagedIdentityID.ValueInt64()
+	id := data.ManagedCredentialsID.ValueInt64()
+	credential, err := common.PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]shared.ListManagedCredentialsRespDto, int64, error) {
+			res, err := r.client.Identities.ListManagedCredentials(ctx, operations.ListManagedCredentialsRequest{
+				ManagedIdentityID: managedIdentityID,
+				OrgID:             &orgID,
+				UserID:            knownInt64(data.UserID),
+				Max:               &max,
+				Offset:            &offset,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing managed credentials: %w", err)
+			}
+			if res.StatusCode != http.StatusOK || res.ListManagedCredentialsResponse == nil {
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing managed credentials", res.RawResponse)
+			}
+			var totalSize int64
+			if res.ListManagedCredentialsResponse.TotalSize != nil {
+				totalSize = *res.ListManagedCredentialsResponse.TotalSize
+			}
+			return res.ListManagedCredentialsResponse.ManagedCredentials, totalSize, nil
+		},
+		func(c *shared.ListManagedCredentialsRespDto) bool {
+			return c.ManagedCredentialsID != nil && *c.ManagedCredentialsID == id
+		},
+	)
+	if err != nil || credential == nil {
+		return false, err
+	}
+
+	data.UserID = types.Int64PointerValue(credential.UserID)
+	data.UserName = types.StringPointerValue(credential.UserName)
+	data.FirstName = types.StringPointerValue(credential.FirstName)
+	data.LastName = types.StringPointerValue(credential.LastName)
+	return true, nil
+}
+
+// credentials builds the SSH credentials from the ResourceModel.
+func credentials(data ResourceModel) *shared.CredentialsInput {
+	return &shared.CredentialsInput{
+		Name:         data.Name.ValueString(),
+		ProviderType: shared.CredentialsProviderTypeSSH,
+		Keys: shared.CreateSecurityKeysSSH(shared.SSHCredentials{
+			PrivateKey: data.PrivateKey.ValueStringPointer(),
+			Passphrase: data.Passphrase.ValueStringPointer(),
+		}),
+	}
+}
+
+// nullUnknowns nulls the computed attributes a failed refresh left unknown.
+func nullUnknowns(data *ResourceModel) {
+	if data.UserID.IsUnknown() {
+		data.UserID = types.Int64Null()
+	}
+	for _, s := range []*types.String{&data.UserName, &data.FirstName, &data.LastName} {
+		if s.IsUnknown() {
+			*s = types.StringNull()
+		}
+	}
+}
+
+// knownInt64 returns the value of v, or nil when it is null or unknown.
+func knownInt64(v types.Int64) *int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueInt64Pointer()
+}
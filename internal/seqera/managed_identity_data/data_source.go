@@ -0,0 +1,146 @@
+// Package managed_identity_data provides the seqera_managed_identity data
+// source.
+package managed_identity_data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/managed_identity"
+)
+
+var _ datasource.DataSource = &DataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *sdk.Seqera
+}
+
+type DataSourceModel struct {
+	OrgID             types.Int64  `tfsdk:"org_id"`
+	Name              types.String `tfsdk:"name"`
+	ManagedIdentityID types.Int64  `tfsdk:"managed_identity_id"`
+	Platform          types.String `tfsdk:"platform"`
+	HostName          types.String `tfsdk:"host_name"`
+	Port              types.Int64  `tfsdk:"port"`
+	HeadQueue         types.String `tfsdk:"head_queue"`
+	ComputeQueue      types.String `tfsdk:"compute_queue"`
+	WorkDir           types.String `tfsdk:"work_dir"`
+	LaunchDir         types.String `tfsdk:"launch_dir"`
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_identity"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Look up an organization managed identity by name.
+
+Use to onboard members with ` + "`seqera_managed_identity_credential`" + ` to a
+managed identity created outside Terraform.`,
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier.`,
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: `Name of the managed identity to look up.`,
+			},
+			"managed_identity_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: `Managed identity numeric identifier.`,
+			},
+			"platform": schema.StringAttribute{
+				Computed:    true,
+				Description: `HPC platform of the cluster.`,
+			},
+			"host_name": schema.StringAttribute{
+				Computed:    true,
+				Description: `Host name of the cluster login node.`,
+			},
+			"port": schema.Int64Attribute{
+				Computed:    true,
+				Description: `SSH port of the login node.`,
+			},
+			"head_queue": schema.StringAttribute{
+				Computed:    true,
+				Description: `Queue the Nextflow head job is submitted to.`,
+			},
+			"compute_queue": schema.StringAttribute{
+				Computed:    true,
+				Description: `Queue the pipeline tasks are submitted to.`,
+			},
+			"work_dir": schema.StringAttribute{
+				Computed:    true,
+				Description: `Default pipeline work directory on the cluster.`,
+			},
+			"launch_dir": schema.StringAttribute{
+				Computed:    true,
+				Description: `Directory Nextflow is launched from.`,
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		d.client = client
+	}
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The search is a substring filter, so keep only the exact name.
+	name := data.Name.ValueString()
+	identity, err := managed_identity.FindManagedIdentity(ctx, d.client, data.OrgID.ValueInt64(), &name, func(m *shared.ManagedIdentityDbDtoAbstractGridConfig) bool {
+		return m.Name != nil && *m.Name == name
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list managed identities", err.Error())
+		return
+	}
+	if identity == nil {
+		resp.Diagnostics.AddError("Managed Identity Not Found", fmt.Sprintf("No managed identity found in org %d with name %q.", data.OrgID.ValueInt64(), name))
+		return
+	}
+
+	data.ManagedIdentityID = types.Int64PointerValue(identity.ID)
+	data.Platform = types.StringNull()
+	if identity.Platform != nil {
+		data.Platform = types.StringValue(string(*identity.Platform))
+	}
+	config := identity.Config
+	if config == nil {
+		config = &shared.AbstractGridConfig{}
+	}
+	data.HostName = types.StringPointerValue(config.HostName)
+	data.Port = types.Int64Null()
+	if config.Port != nil {
+		data.Port = types.Int64Value(int64(*config.Port))
+	}
+	data.HeadQueue = types.StringPointerValue(config.HeadQueue)
+	data.ComputeQueue = types.StringPointerValue(config.ComputeQueue)
+	data.WorkDir = types.StringPointerValue(config.WorkDir)
+	data.LaunchDir = types.StringPointerValue(config.LaunchDir)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
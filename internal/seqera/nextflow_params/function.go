@@ -0,0 +1,161 @@
+// Package nextflow_params provides the provider::seqera::nextflow_params
+// function, rendering pipeline parameters as canonical YAML.
+package nextflow_params
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+var _ function.Function = &Function{}
+
+func NewFunction() function.Function {
+	return &Function{}
+}
+
+type Function struct{}
+
+func (f *Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "nextflow_params"
+}
+
+func (f *Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render pipeline parameters as YAML for params_text",
+		MarkdownDescription: `Render a map or object of Nextflow pipeline parameters as the YAML ` + "`params_text`" + ` takes. Keys are sorted and indented the same way on every run, so the rendered text only changes when a parameter does, unlike ` + "`yamlencode`" + ` whose output is not guaranteed stable across Terraform releases. Nested objects, lists, numbers and booleans keep their types.
+
+` + "```hcl" + `
+resource "seqera_pipeline" "rnaseq" {
+  # ...
+  launch = {
+    # ...
+    params_text = provider::seqera::nextflow_params({
+      input   = "s3://my-bucket/samplesheet.csv"
+      outdir  = "s3://my-bucket/results"
+      genome  = "GRCh38"
+      skip_qc = false
+    })
+  }
+}
+` + "```",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "params_map",
+				Description: `Pipeline parameters, as a map or object.`,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var params types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &params)
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := params.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if !value.Type().Is(tftypes.Object{}) && !value.Type().Is(tftypes.Map{}) {
+		resp.Error = function.NewArgumentFuncError(0, "params_map must be a map or an object")
+		return
+	}
+	text, err := Render(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, text)
+}
+
+// Render writes a Terraform value as YAML, with sorted keys and a two-space
+// indent.
+func Render(value tftypes.Value) (string, error) {
+	native, err := toNative(value)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(native); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// toNative converts a Terraform value to the Go value yaml.v3 encodes the
+// same way, mapping objects and maps to maps, whose keys yaml.v3 sorts.
+func toNative(value tftypes.Value) (any, error) {
+	if !value.IsKnown() {
+		return nil, fmt.Errorf("params_map must be known")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return s, err
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return b, err
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		if i, accuracy := n.Int64(); accuracy == big.Exact {
+			return i, nil
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case value.Type().Is(tftypes.Object{}), value.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		out := make(map[string]any, len(elements))
+		for key, element := range elements {
+			native, err := toNative(element)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = native
+		}
+		return out, nil
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		out := make([]any, len(elements))
+		for i, element := range elements {
+			native, err := toNative(element)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = native
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unsupported parameter type %s", value.Type())
+}
@@ -91,7 +91,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 	if listRes.StatusCode != 200 {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "listing organizations", listRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "listing organizations", listRes.RawResponse, req.Config.Raw.Type())
 		return
 	}
 	if listRes.ListOrganizationsResponse == nil {
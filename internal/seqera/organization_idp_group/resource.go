@@ -0,0 +1,273 @@
+// Package organization_idp_group provides the seqera_organization_idp_group
+// resource.
+package organization_idp_group
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// identityAttributes identifies an IdP group by organization and ID.
+var identityAttributes = common.OrgIdentity("group_id")
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *sdk.Seqera
+}
+
+type ResourceModel struct {
+	OrgID   types.Int64  `tfsdk:"org_id"`
+	GroupID types.Int64  `tfsdk:"group_id"`
+	Name    types.String `tfsdk:"name"`
+	Source  types.String `tfsdk:"source"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_idp_group"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Register an identity provider group with an organization.
+
+A team linked to a registered group takes its members from SSO group
+membership; ` + "`seqera_teams`" + ` reports the linked group in ` + "`idp_group_name`" + `.
+Groups created here have the source MANUAL. Groups provisioned by an
+identity provider over SCIM have the source SCIM and cannot be deleted from
+Terraform; import them to read them, or use the
+` + "`seqera_organization_idp_groups`" + ` data source.
+
+Import format: org_id/group_id (e.g., "12345/67")
+`,
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier. Requires replacement if changed.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: `IdP group numeric identifier.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: `Group name as sent by the identity provider, such as the Okta group name or the Entra ID group object ID. Requires replacement if changed.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source": schema.StringAttribute{
+				Computed:    true,
+				Description: `How the group was registered: MANUAL or SCIM.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.OrgIdentitySchema(identityschema.Int64Attribute{
+		RequiredForImport: true,
+		Description:       `IdP group numeric identifier.`,
+	})
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Orgs.CreateOrganizationIdpGroup(ctx, operations.CreateOrganizationIdpGroupRequest{
+		OrgID: data.OrgID.ValueInt64(),
+		CreateIdpGroupRequest: shared.CreateIdpGroupRequest{
+			DisplayName: data.Name.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create IdP group", err.Error())
+		return
+	}
+	if res.StatusCode == http.StatusConflict {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"IdP Group Already Registered",
+			fmt.Sprintf("The organization already has an IdP group named %q. Import it with the ID %d/<group_id>.",
+				data.Name.ValueString(), data.OrgID.ValueInt64()),
+		)
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.IdpGroupEntry == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "creating IdP group", res.RawResponse, req.Plan.Raw.Type())
+		return
+	}
+
+	refreshFromGroup(&data, res.IdpGroupEntry)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := data.GroupID.ValueInt64()
+	group, err := FindGroup(ctx, r.client, data.OrgID.ValueInt64(), func(g *shared.IdpGroupEntry) bool {
+		return g.ID != nil && *g.ID == groupID
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read IdP group", err.Error())
+		return
+	}
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	refreshFromGroup(&data, group)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	// All configurable attributes require replacement, so this should never be called
+	resp.Diagnostics.AddError("Update Not Supported", "IdP groups cannot be updated in place.")
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Orgs.DeleteOrganizationIdpGroup(ctx, operations.DeleteOrganizationIdpGroupRequest{
+		OrgID:   data.OrgID.ValueInt64(),
+		GroupID: data.GroupID.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete IdP group", err.Error())
+		return
+	}
+	switch res.StatusCode {
+	case http.StatusNoContent, http.StatusNotFound:
+	case http.StatusConflict:
+		detail := fmt.Sprintf("IdP group %q is provisioned by the identity provider over SCIM. Remove it from the identity provider, or remove it from the Terraform state with terraform state rm.", data.Name.ValueString())
+		if res.ErrorResponse != nil && res.ErrorResponse.Message != "" {
+			detail = res.ErrorResponse.Message + "\n\n" + detail
+		}
+		resp.Diagnostics.AddError("IdP Group Managed by SCIM", detail)
+	default:
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "deleting IdP group", res.RawResponse, req.State.Raw.Type())
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
+		return
+	}
+
+	// Import format: org_id/group_id
+	attributes, err := common.ParseImportID("seqera_organization_idp_group", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	// ParseImportID has checked that both are numbers.
+	for _, name := range []string{"org_id", "group_id"} {
+		value, _ := strconv.ParseInt(attributes[name], 10, 64)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// FindGroup returns the first IdP group of the organization accepted by
+// match, or nil if there is none.
+func FindGroup(ctx context.Context, client *sdk.Seqera, orgID int64, match func(*shared.IdpGroupEntry) bool) (*shared.IdpGroupEntry, error) {
+	groups, err := ListGroups(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		if match(&groups[i]) {
+			return &groups[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ListGroups returns the IdP groups registered with the organization. The
+// endpoint is not paginated.
+func ListGroups(ctx context.Context, client *sdk.Seqera, orgID int64) ([]shared.IdpGroupEntry, error) {
+	res, err := client.Orgs.ListOrganizationIdpGroups(ctx, operations.ListOrganizationIdpGroupsRequest{OrgID: orgID})
+	if err != nil {
+		return nil, fmt.Errorf("listing IdP groups: %w", err)
+	}
+	if res.StatusCode != http.StatusOK || res.ListIdpGroupsResponse == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "listing IdP groups", res.RawResponse)
+	}
+	return res.ListIdpGroupsResponse.Groups, nil
+}
+
+// refreshFromGroup updates the ResourceModel from API response.
+func refreshFromGroup(data *ResourceModel, group *shared.IdpGroupEntry) {
+	data.GroupID = types.Int64PointerValue(group.ID)
+	data.Name = types.StringPointerValue(group.DisplayName)
+	data.Source = types.StringPointerValue((*string)(group.Source))
+}
@@ -0,0 +1,115 @@
+// Package organization_idp_groups_data provides the
+// seqera_organization_idp_groups data source.
+package organization_idp_groups_data
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/organization_idp_group"
+)
+
+var _ datasource.DataSource = &DataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *sdk.Seqera
+}
+
+type groupModel struct {
+	GroupID types.Int64  `tfsdk:"group_id"`
+	Name    types.String `tfsdk:"name"`
+	Source  types.String `tfsdk:"source"`
+}
+
+type DataSourceModel struct {
+	OrgID  types.Int64  `tfsdk:"org_id"`
+	Source types.String `tfsdk:"source"`
+	Groups []groupModel `tfsdk:"groups"`
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_idp_groups"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the identity provider groups registered with an organization.
+
+Includes the groups provisioned over SCIM, which cannot be managed with
+` + "`seqera_organization_idp_group`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier.`,
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: `Only list groups with this source: MANUAL or SCIM.`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(shared.OrgIdpGroupSourceManual), string(shared.OrgIdpGroupSourceScim)),
+				},
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: `IdP groups, in the order returned by the API.`,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.Int64Attribute{Computed: true, Description: "IdP group numeric identifier."},
+						"name":     schema.StringAttribute{Computed: true, Description: "Group name as sent by the identity provider."},
+						"source":   schema.StringAttribute{Computed: true, Description: "How the group was registered: MANUAL or SCIM."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		d.client = client
+	}
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := organization_idp_group.ListGroups(ctx, d.client, data.OrgID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list IdP groups", err.Error())
+		return
+	}
+
+	// The platform has no source filter, so it is applied here.
+	data.Groups = []groupModel{}
+	for _, g := range groups {
+		source := types.StringPointerValue((*string)(g.Source))
+		if !data.Source.IsNull() && !source.Equal(data.Source) {
+			continue
+		}
+		data.Groups = append(data.Groups, groupModel{
+			GroupID: types.Int64PointerValue(g.ID),
+			Name:    types.StringPointerValue(g.DisplayName),
+			Source:  source,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
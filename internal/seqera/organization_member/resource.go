@@ -5,11 +5,11 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,8 +27,12 @@ import (
 var (
 	_ resource.Resource                = &Resource{}
 	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
 )
 
+// identityAttributes identifies a member by organization and email.
+var identityAttributes = common.IdentityAttributes{"org_id": "org_id", "email": "email"}
+
 func NewResource() resource.Resource {
 	return &Resource{}
 }
@@ -139,6 +143,21 @@ Import format: org_id/email (e.g., "12345/user@example.com")
 	}
 }
 
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Organization numeric identifier.`,
+			},
+			"email": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       `Email address of the member.`,
+			},
+		},
+	}
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, diags := common.ConfigureClient(req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -148,6 +167,8 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -169,7 +190,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 	if createRes.StatusCode != 200 || createRes.AddMemberResponse == nil || createRes.AddMemberResponse.Member == nil {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "adding organization member", createRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "adding organization member", createRes.RawResponse, req.Plan.Raw.Type())
 		return
 	}
 
@@ -195,7 +216,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 			return
 		}
 		if updateRes.StatusCode != 204 {
-			resp.Diagnostics.AddError("Failed to update member role", common.DebugResponse(updateRes.RawResponse))
+			common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "updating member role", updateRes.RawResponse, req.Plan.Raw.Type())
 			return
 		}
 		data.Role = types.StringValue(desiredRole)
@@ -205,6 +226,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -233,6 +256,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	var state ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -257,7 +282,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 	if updateRes.StatusCode != 204 {
-		resp.Diagnostics.AddError("Failed to update member role", common.DebugResponse(updateRes.RawResponse))
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "updating member role", updateRes.RawResponse, req.Plan.Raw.Type())
 		return
 	}
 
@@ -294,32 +319,25 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 	if deleteRes.StatusCode != 204 && deleteRes.StatusCode != 404 {
-		resp.Diagnostics.AddError("Failed to delete organization member", common.DebugResponse(deleteRes.RawResponse))
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "deleting organization member", deleteRes.RawResponse, req.State.Raw.Type())
 	}
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: org_id/email
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected format: org_id/email, got: %s", req.ID),
-		)
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
 		return
 	}
 
-	orgID, err := strconv.ParseInt(parts[0], 10, 64)
+	// Import format: org_id/email
+	attributes, err := common.ParseImportID("seqera_organization_member", req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid org_id",
-			fmt.Sprintf("org_id must be a number, got: %s", parts[0]),
-		)
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
-
+	// ParseImportID has checked that org_id is a number.
+	orgID, _ := strconv.ParseInt(attributes["org_id"], 10, 64)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), attributes["email"])...)
 }
 
 // findMember searches for a member by email or member_id.
@@ -352,7 +370,7 @@ func (r *Resource) findMember(ctx context.Context, orgID int64, email string, me
 				return nil, 0, err
 			}
 			if listRes.StatusCode != 200 {
-				return nil, 0, common.UnexpectedStatusErr("listing organization members", listRes.RawResponse)
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing organization members", listRes.RawResponse)
 			}
 			if listRes.ListMembersResponse == nil {
 				return nil, 0, fmt.Errorf("empty response listing organization members")
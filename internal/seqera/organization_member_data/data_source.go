@@ -109,7 +109,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 	if listRes.StatusCode != 200 {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "listing organization members", listRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "listing organization members", listRes.RawResponse, req.Config.Raw.Type())
 		return
 	}
 	if listRes.ListMembersResponse == nil {
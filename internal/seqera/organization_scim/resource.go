@@ -0,0 +1,428 @@
+// Package organization_scim provides the seqera_organization_scim resource,
+// which manages the SCIM provisioning token of an organization and rotates it
+// on a trigger or a schedule.
+package organization_scim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// identityAttributes identifies the SCIM configuration by its organization;
+// an organization has at most one active token.
+var identityAttributes = common.IdentityAttributes{"org_id": "org_id"}
+
+// tokenAttributes change whenever the token is generated again.
+var tokenAttributes = []string{"token", "masked_token", "token_created_at", "token_last_used"}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *sdk.Seqera
+}
+
+type ResourceModel struct {
+	OrgID           types.Int64  `tfsdk:"org_id"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	RotateAfterDays types.Int64  `tfsdk:"rotate_after_days"`
+	Token           types.String `tfsdk:"token"`
+	MaskedToken     types.String `tfsdk:"masked_token"`
+	EndpointURL     types.String `tfsdk:"endpoint_url"`
+	TokenCreatedAt  types.String `tfsdk:"token_created_at"`
+	TokenLastUsed   types.String `tfsdk:"token_last_used"`
+	SSOActive       types.Bool   `tfsdk:"sso_active"`
+	GroupCount      types.Int64  `tfsdk:"group_count"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_scim"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage the SCIM provisioning token of an organization.
+
+Creating the resource generates a token, revoking the active one if the
+organization already has a token. The token is rotated when
+` + "`rotation_trigger`" + ` changes, or at the first apply at least
+` + "`rotate_after_days`" + ` days after it was generated. Destroying the resource
+revokes the token.
+
+` + "`token`" + ` is only known after the provider generates it, and is kept in
+state, marked sensitive, until the next rotation. It is null after import and
+when the token was rotated outside Terraform. To keep the token out of state
+altogether, use the ` + "`seqera_scim_token`" + ` ephemeral resource instead,
+bearing in mind that it rotates the token in plans as well as in applies.
+
+Import format: org_id (e.g., "12345")
+`,
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier. Requires replacement if changed.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: `Arbitrary value; changing it rotates the token, for example the ID of a time_rotating resource. Setting it on a resource that had none does not rotate.`,
+			},
+			"rotate_after_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Rotate the token at the first apply at least this many days after it was generated.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: `SCIM bearer token, to configure in the identity provider. Null after import and when the token was rotated outside Terraform.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"masked_token": schema.StringAttribute{
+				Computed:    true,
+				Description: `Masked form of the token, as shown in the platform.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_url": schema.StringAttribute{
+				Computed:    true,
+				Description: `SCIM endpoint URL of the organization, to configure in the identity provider.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: `RFC3339 timestamp when the token was generated.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_last_used": schema.StringAttribute{
+				Computed:    true,
+				Description: `RFC3339 timestamp when the identity provider last used the token.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sso_active": schema.BoolAttribute{
+				Computed:    true,
+				Description: `Whether single sign-on is active for the organization.`,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: `Number of groups the identity provider has provisioned.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Organization numeric identifier.`,
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+// ModifyPlan marks the token as changing when an update will rotate it, so
+// that the plan shows the rotation and Update knows to rotate.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !rotationTriggered(state.RotationTrigger, plan.RotationTrigger) && !rotationDue(state.TokenCreatedAt, plan.RotateAfterDays, time.Now()) {
+		return
+	}
+
+	for _, name := range tokenAttributes {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	orgID := data.OrgID.ValueInt64()
+
+	config, err := r.describe(ctx, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read SCIM configuration", err.Error())
+		return
+	}
+
+	// Like the seqera_scim_token ephemeral resource, an active token is
+	// rotated rather than failing the create.
+	var token *shared.CreateScimTokenResponse
+	if config.HasActiveToken != nil && *config.HasActiveToken {
+		token, err = r.rotate(ctx, orgID)
+	} else {
+		res, createErr := r.client.Orgs.CreateOrganizationScimToken(ctx, operations.CreateOrganizationScimTokenRequest{
+			OrgID: orgID,
+		})
+		switch {
+		case createErr != nil:
+			err = fmt.Errorf("creating SCIM token: %w", createErr)
+		case res.StatusCode != http.StatusOK || res.CreateScimTokenResponse == nil:
+			err = common.UnexpectedStatusErr(ctx, "creating SCIM token", res.RawResponse)
+		default:
+			token = res.CreateScimTokenResponse
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to generate SCIM token", err.Error())
+		return
+	}
+
+	r.saveToken(ctx, &data, token, resp.Diagnostics.AddWarning)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.describe(ctx, data.OrgID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read SCIM configuration", err.Error())
+		return
+	}
+	// A revoked token leaves nothing to manage.
+	if config.HasActiveToken == nil || !*config.HasActiveToken {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The stored token is stale once it has been rotated outside Terraform.
+	if !data.MaskedToken.Equal(types.StringPointerValue(config.MaskedToken)) {
+		data.Token = types.StringNull()
+	}
+	refreshFromConfig(&data, config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ModifyPlan leaves the token unknown when it is due for rotation; any
+	// other change only concerns the rotation settings.
+	if data.MaskedToken.IsUnknown() {
+		token, err := r.rotate(ctx, data.OrgID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to rotate SCIM token", err.Error())
+			return
+		}
+		r.saveToken(ctx, &data, token, resp.Diagnostics.AddWarning)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Orgs.RevokeOrganizationScimToken(ctx, operations.RevokeOrganizationScimTokenRequest{
+		OrgID: data.OrgID.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to revoke SCIM token", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "revoking SCIM token", res.RawResponse, req.State.Raw.Type())
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
+		return
+	}
+
+	// Import format: org_id
+	attributes, err := common.ParseImportID("seqera_organization_scim", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	// ParseImportID has checked that it is a number.
+	orgID, _ := strconv.ParseInt(attributes["org_id"], 10, 64)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
+}
+
+func (r *Resource) describe(ctx context.Context, orgID int64) (*shared.DescribeScimConfigResponse, error) {
+	res, err := r.client.Orgs.DescribeOrganizationScimConfig(ctx, operations.DescribeOrganizationScimConfigRequest{
+		OrgID: orgID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing SCIM configuration: %w", err)
+	}
+	if res.StatusCode != http.StatusOK || res.DescribeScimConfigResponse == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "describing SCIM configuration", res.RawResponse)
+	}
+	return res.DescribeScimConfigResponse, nil
+}
+
+func (r *Resource) rotate(ctx context.Context, orgID int64) (*shared.CreateScimTokenResponse, error) {
+	res, err := r.client.Orgs.RotateOrganizationScimToken(ctx, operations.RotateOrganizationScimTokenRequest{
+		OrgID: orgID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rotating SCIM token: %w", err)
+	}
+	if res.StatusCode != http.StatusOK || res.CreateScimTokenResponse == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "rotating SCIM token", res.RawResponse)
+	}
+	return res.CreateScimTokenResponse, nil
+}
+
+// saveToken stores a newly generated token in data and reads back when it
+// was generated. The token has been generated either way, so failing to read
+// the configuration is only a warning. Only the attributes the plan left
+// unknown are filled in, so an update keeps the rest of its plan.
+func (r *Resource) saveToken(ctx context.Context, data *ResourceModel, token *shared.CreateScimTokenResponse, warn func(summary, detail string)) {
+	data.Token = types.StringPointerValue(token.Token)
+	data.MaskedToken = types.StringPointerValue(token.MaskedToken)
+	if data.EndpointURL.IsUnknown() {
+		data.EndpointURL = types.StringPointerValue(token.EndpointURL)
+	}
+
+	config, err := r.describe(ctx, data.OrgID.ValueInt64())
+	if err != nil {
+		warn("Failed to read SCIM configuration", err.Error())
+		config = &shared.DescribeScimConfigResponse{}
+	}
+	data.TokenCreatedAt = rfc3339(config.TokenCreatedAt)
+	data.TokenLastUsed = rfc3339(config.TokenLastUsed)
+	if data.SSOActive.IsUnknown() {
+		data.SSOActive = types.BoolPointerValue(config.SsoActive)
+	}
+	if data.GroupCount.IsUnknown() {
+		data.GroupCount = groupCount(config.GroupCount)
+	}
+}
+
+// refreshFromConfig updates the ResourceModel from API response. The token
+// itself is never returned by the describe operation.
+func refreshFromConfig(data *ResourceModel, config *shared.DescribeScimConfigResponse) {
+	data.MaskedToken = types.StringPointerValue(config.MaskedToken)
+	data.EndpointURL = types.StringPointerValue(config.EndpointURL)
+	data.TokenCreatedAt = rfc3339(config.TokenCreatedAt)
+	data.TokenLastUsed = rfc3339(config.TokenLastUsed)
+	data.SSOActive = types.BoolPointerValue(config.SsoActive)
+	data.GroupCount = groupCount(config.GroupCount)
+}
+
+func groupCount(count *int) types.Int64 {
+	if count == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*count))
+}
+
+// rotationTriggered reports whether rotation_trigger changed. Setting it for
+// the first time, such as after an import, does not rotate.
+func rotationTriggered(current, planned types.String) bool {
+	return !current.IsNull() && !current.Equal(planned)
+}
+
+// rotationDue reports whether the token generated at createdAt is at least
+// days old.
+func rotationDue(createdAt types.String, days types.Int64, now time.Time) bool {
+	if createdAt.IsNull() || createdAt.IsUnknown() || days.IsNull() || days.IsUnknown() {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339, createdAt.ValueString())
+	if err != nil {
+		return false
+	}
+	return !now.Before(created.AddDate(0, 0, int(days.ValueInt64())))
+}
+
+func rfc3339(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
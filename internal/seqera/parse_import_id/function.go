@@ -0,0 +1,69 @@
+// Package parse_import_id provides the provider::seqera::parse_import_id
+// function, splitting a resource import ID into attribute values.
+package parse_import_id
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var _ function.Function = &Function{}
+
+func NewFunction() function.Function {
+	return &Function{}
+}
+
+type Function struct{}
+
+func (f *Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a resource import ID into attribute values",
+		MarkdownDescription: `Split the ` + "`terraform import`" + ` ID of a resource into the attribute values it holds, keyed by resource attribute. Numeric attributes are returned as strings; wrap them in ` + "`tonumber`" + ` where a number is needed.
+
+The ID is checked against the format the resource imports, so this also validates IDs passed to a module:
+
+` + "```hcl" + `
+provider::seqera::parse_import_id("seqera_workspace_participant", "12345/67890/team:42")
+# => { org_id = "12345", workspace_id = "67890", team_id = "42" }
+` + "```",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: `Resource type, such as "seqera_pipeline".`,
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: `Import ID of the resource.`,
+			},
+		},
+		Return: function.MapReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, id string
+	resp.Error = req.Arguments.Get(ctx, &resourceType, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	attributes, err := common.ParseImportID(resourceType, id)
+	if errors.Is(err, common.ErrNoImportID) {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, attributes)
+}
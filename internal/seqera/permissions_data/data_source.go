@@ -108,7 +108,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 	if res.StatusCode != 200 {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "listing permissions", res.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "listing permissions", res.RawResponse, req.Config.Raw.Type())
 		return
 	}
 	if res.ListRolePermissionsResponse == nil {
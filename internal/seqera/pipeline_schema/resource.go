@@ -19,6 +19,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -32,7 +33,13 @@ import (
 	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
 )
 
-var _ resource.Resource = &Resource{}
+var (
+	_ resource.Resource               = &Resource{}
+	_ resource.ResourceWithModifyPlan = &Resource{}
+	_ resource.ResourceWithIdentity   = &Resource{}
+)
+
+var identityAttributes = common.WorkspaceIdentity("id")
 
 func NewResource() resource.Resource {
 	return &Resource{}
@@ -84,10 +91,11 @@ orphaned server-side.
 `,
 		Attributes: map[string]schema.Attribute{
 			"workspace_id": schema.Int64Attribute{
-				Required:    true,
-				Description: `Workspace numeric identifier the schema is created in.`,
+				Computed:    true,
+				Optional:    true,
+				Description: `Workspace numeric identifier the schema is created in. Defaults to the provider default_workspace when omitted.`,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
 				},
 				Validators: []validator.Int64{
 					int64validator.AtLeast(1),
@@ -111,6 +119,13 @@ orphaned server-side.
 	}
 }
 
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.WorkspaceIdentitySchema(identityschema.Int64Attribute{
+		RequiredForImport: true,
+		Description:       `Pipeline schema numeric identifier.`,
+	})
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, diags := common.ConfigureClient(req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -119,7 +134,15 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 }
 
+// ModifyPlan fills workspace_id from the provider default_workspace when it
+// is omitted.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+}
+
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -143,7 +166,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		res.CreatePipelineSchemaResponse == nil ||
 		res.CreatePipelineSchemaResponse.PipelineSchema == nil ||
 		res.CreatePipelineSchemaResponse.PipelineSchema.ID == nil {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "creating pipeline schema", res.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "creating pipeline schema", res.RawResponse, req.Plan.Raw.Type())
 		return
 	}
 
@@ -155,6 +178,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 // state as the source of truth. Schema rows are immutable server-side, so
 // drift between state and server is not expected.
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -167,6 +192,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 // terraform will destroy+create rather than call Update. Implemented to
 // satisfy the resource.Resource interface.
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
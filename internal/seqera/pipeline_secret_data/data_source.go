@@ -94,7 +94,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 	if listRes.StatusCode != 200 {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "listing pipeline secrets", listRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "listing pipeline secrets", listRes.RawResponse, req.Config.Raw.Type())
 		return
 	}
 	if listRes.ListPipelineSecretsResponse == nil {
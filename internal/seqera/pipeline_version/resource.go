@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -34,7 +35,14 @@ import (
 	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
 )
 
-var _ resource.Resource = &Resource{}
+var (
+	_ resource.Resource               = &Resource{}
+	_ resource.ResourceWithModifyPlan = &Resource{}
+	_ resource.ResourceWithIdentity   = &Resource{}
+)
+
+// identityAttributes identifies a version by workspace, pipeline and version ID.
+var identityAttributes = common.IdentityAttributes{"workspace_id": "workspace_id", "pipeline_id": "pipeline_id", "id": "version_id"}
 
 func NewResource() resource.Resource {
 	return &Resource{}
@@ -102,10 +110,11 @@ version remains in the platform.`,
 				},
 			},
 			"workspace_id": schema.Int64Attribute{
-				Required:    true,
-				Description: `Workspace numeric identifier.`,
+				Computed:    true,
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
 				},
 				Validators: []validator.Int64{
 					int64validator.AtLeast(1),
@@ -151,6 +160,25 @@ version remains in the platform.`,
 	}
 }
 
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.Int64Attribute{
+				OptionalForImport: true,
+				Description:       `Workspace numeric identifier. Defaults to the provider default_workspace on import.`,
+			},
+			"pipeline_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Pipeline numeric identifier.`,
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       `Pipeline version string identifier.`,
+			},
+		},
+	}
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -166,6 +194,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	r.client = client
 }
 
+// ModifyPlan fills workspace_id from the provider default_workspace when it
+// is omitted.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+}
+
 // manage calls PUT /manage. Always re-assert isDefault: the platform reads
 // an omitted/false isDefault as "demote", which 409s on the current default.
 func (r *Resource) manage(ctx context.Context, data ResourceModel) error {
@@ -186,7 +220,7 @@ func (r *Resource) manage(ctx context.Context, data ResourceModel) error {
 		return err
 	}
 	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status %d:\n%s", res.StatusCode, common.DebugResponse(res.RawResponse))
+		return common.UnexpectedStatusErr(ctx, "managing pipeline version", res.RawResponse)
 	}
 	return nil
 }
@@ -210,7 +244,7 @@ func (r *Resource) refresh(ctx context.Context, data *ResourceModel) (bool, erro
 		return false, nil
 	}
 	if res.StatusCode != http.StatusOK || res.ListPipelineVersionsResponse == nil {
-		return false, fmt.Errorf("unexpected status %d listing versions:\n%s", res.StatusCode, common.DebugResponse(res.RawResponse))
+		return false, common.UnexpectedStatusErr(ctx, "listing pipeline versions", res.RawResponse)
 	}
 	for _, p := range res.ListPipelineVersionsResponse.Versions {
 		v := p.Version
@@ -236,6 +270,8 @@ func (r *Resource) applyAndRefresh(ctx context.Context, data *ResourceModel) (fo
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -257,6 +293,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -275,6 +313,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var plan, state ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
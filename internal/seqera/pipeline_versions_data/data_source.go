@@ -130,7 +130,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 	if res.StatusCode != http.StatusOK || res.ListPipelineVersionsResponse == nil {
-		resp.Diagnostics.AddError("Unexpected API response", common.DebugResponse(res.RawResponse))
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "listing pipeline versions", res.RawResponse, req.Config.Raw.Type())
 		return
 	}
 
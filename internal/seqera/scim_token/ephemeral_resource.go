@@ -0,0 +1,169 @@
+// Package scim_token provides the seqera_scim_token ephemeral resource, which
+// generates an organization SCIM bearer token to hand to an identity provider
+// without storing it in state.
+package scim_token
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralResource{}
+)
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{}
+}
+
+type EphemeralResource struct {
+	client *sdk.Seqera
+}
+
+type EphemeralResourceModel struct {
+	OrgID       types.Int64  `tfsdk:"org_id"`
+	Token       types.String `tfsdk:"token"`
+	MaskedToken types.String `tfsdk:"masked_token"`
+	EndpointURL types.String `tfsdk:"endpoint_url"`
+	Rotated     types.Bool   `tfsdk:"rotated"`
+}
+
+func (r *EphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_token"
+}
+
+func (r *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Generate a SCIM bearer token for an organization, to push into an identity provider without storing it in state or plan files.
+
+The token is generated with ` + "`POST /orgs/{orgId}/scim/token`" + `, or with ` + "`POST /orgs/{orgId}/scim/token/rotate`" + ` when the organization already has an active token. Either way the previous token is revoked.
+
+~> **Warning:** Terraform opens ephemeral resources in every plan as well as in every apply, and each open revokes the active token. A plan on its own, such as a speculative plan for a pull request, therefore revokes the token the identity provider is using, and the token generated in its place is thrown away. To rotate the token only when a change is applied, use the ` + "`seqera_organization_scim`" + ` resource instead.
+
+Only enable this resource in the run that should rotate the token, and make that run a ` + "`terraform apply -var scim_token_version=N`" + ` without a saved plan, so that the token generated by its apply step is the one sent on. Never set ` + "`scim_token_version`" + ` for a plan-only run. Gate the resource with ` + "`count`" + ` and send the token through a write-only argument whose version changes in that run:
+
+` + "```hcl" + `
+variable "scim_token_version" {
+  type    = number
+  default = 0
+}
+
+ephemeral "seqera_scim_token" "okta" {
+  count  = var.scim_token_version > 0 ? 1 : 0
+  org_id = seqera_orgs.main.org_id
+}
+
+resource "aws_secretsmanager_secret_version" "scim_token" {
+  secret_id                = aws_secretsmanager_secret.scim_token.id
+  secret_string_wo         = try(ephemeral.seqera_scim_token.okta[0].token, "")
+  secret_string_wo_version = var.scim_token_version
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.Int64Attribute{
+				Required:    true,
+				Description: `Organization numeric identifier.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: `SCIM bearer token. The platform only returns it once.`,
+			},
+			"masked_token": schema.StringAttribute{
+				Computed:    true,
+				Description: `Masked form of the token, as shown in the platform.`,
+			},
+			"endpoint_url": schema.StringAttribute{
+				Computed:    true,
+				Description: `SCIM endpoint URL of the organization, to configure in the identity provider.`,
+			},
+			"rotated": schema.BoolAttribute{
+				Computed:    true,
+				Description: `Whether an active token was revoked to generate this one.`,
+			},
+		},
+	}
+}
+
+func (r *EphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+// Open generates a new token, revoking the active one. The provider cannot
+// tell a plan-time open from an apply-time one, so the schema description
+// asks for the resource to be enabled only in runs that apply.
+func (r *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	orgID := data.OrgID.ValueInt64()
+
+	configRes, err := r.client.Orgs.DescribeOrganizationScimConfig(ctx, operations.DescribeOrganizationScimConfigRequest{
+		OrgID: orgID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read SCIM configuration", err.Error())
+		return
+	}
+	if configRes.StatusCode != http.StatusOK || configRes.DescribeScimConfigResponse == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "reading SCIM configuration", configRes.RawResponse, req.Config.Raw.Type())
+		return
+	}
+	rotate := configRes.DescribeScimConfigResponse.HasActiveToken != nil && *configRes.DescribeScimConfigResponse.HasActiveToken
+
+	var token *shared.CreateScimTokenResponse
+	if rotate {
+		res, err := r.client.Orgs.RotateOrganizationScimToken(ctx, operations.RotateOrganizationScimTokenRequest{
+			OrgID: orgID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to rotate SCIM token", err.Error())
+			return
+		}
+		if res.StatusCode != http.StatusOK || res.CreateScimTokenResponse == nil {
+			common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "rotating SCIM token", res.RawResponse, req.Config.Raw.Type())
+			return
+		}
+		token = res.CreateScimTokenResponse
+	} else {
+		res, err := r.client.Orgs.CreateOrganizationScimToken(ctx, operations.CreateOrganizationScimTokenRequest{
+			OrgID: orgID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create SCIM token", err.Error())
+			return
+		}
+		if res.StatusCode != http.StatusOK || res.CreateScimTokenResponse == nil {
+			common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "creating SCIM token", res.RawResponse, req.Config.Raw.Type())
+			return
+		}
+		token = res.CreateScimTokenResponse
+	}
+
+	data.Token = types.StringPointerValue(token.Token)
+	data.MaskedToken = types.StringPointerValue(token.MaskedToken)
+	data.EndpointURL = types.StringPointerValue(token.EndpointURL)
+	data.Rotated = types.BoolValue(rotate)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,70 @@
+package scim_token
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+// Every open revokes the active token, whether Terraform is planning or
+// applying, which is why the description asks for the resource to be
+// enabled only in runs that apply.
+func TestOpenRevokesActiveToken(t *testing.T) {
+	var rotations int
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /orgs/7/scim/config":
+			_ = json.NewEncoder(w).Encode(map[string]any{"hasActiveToken": true})
+		case "POST /orgs/7/scim/token/rotate":
+			rotations++
+			_ = json.NewEncoder(w).Encode(map[string]any{"token": "scim-token", "maskedToken": "scim-****"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer api.Close()
+
+	ctx := context.Background()
+	r := &EphemeralResource{client: sdk.New(sdk.WithServerURL(api.URL))}
+	var schema ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schema)
+	objectType := schema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for attribute, attributeType := range objectType.AttributeTypes {
+		values[attribute] = tftypes.NewValue(attributeType, nil)
+	}
+	values["org_id"] = tftypes.NewValue(tftypes.Number, 7)
+	config := tfsdk.Config{Schema: schema.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	// A plan followed by an apply.
+	for open := 1; open <= 2; open++ {
+		resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schema.Schema, Raw: config.Raw.Copy()}}
+		r.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		var data EphemeralResourceModel
+		resp.Diagnostics.Append(resp.Result.Get(ctx, &data)...)
+		if data.Token.ValueString() != "scim-token" || !data.Rotated.ValueBool() {
+			t.Errorf("open %d: expected a rotated token, got %v", open, data)
+		}
+		if rotations != open {
+			t.Errorf("open %d: expected %d rotations, got %d", open, open, rotations)
+		}
+	}
+
+	if !strings.Contains(schema.Schema.MarkdownDescription, "seqera_organization_scim") {
+		t.Error("expected the description to point to seqera_organization_scim")
+	}
+}
@@ -0,0 +1,338 @@
+// Package ssh_key provides the seqera_ssh_key resource.
+package ssh_key
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// identityAttributes identifies an SSH key by its ID; keys belong to the
+// user the provider authenticates as.
+var identityAttributes = common.IdentityAttributes{"id": "key_id"}
+
+// nameCheck checks at plan time that no other key of the user has the name.
+var nameCheck = common.NameCheck{
+	Kind: "SSH key",
+	Name: path.Root("name"),
+	Validate: func(ctx context.Context, client *sdk.Seqera, _ *int64, name string) (int, string, error) {
+		res, err := client.SSHKeys.ValidateSSHKeyName(ctx, operations.ValidateSSHKeyNameRequest{Name: &name})
+		if err != nil {
+			return 0, "", err
+		}
+		return res.StatusCode, "", nil
+	},
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *sdk.Seqera
+}
+
+type ResourceModel struct {
+	KeyID       types.Int64  `tfsdk:"key_id"`
+	Name        types.String `tfsdk:"name"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	DateCreated types.String `tfsdk:"date_created"`
+	LastUsed    types.String `tfsdk:"last_used"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Register an SSH public key for the user the provider authenticates as.
+
+Registered keys connect to studios created with ` + "`ssh_enabled = true`" + `. The
+key format is checked with the platform at plan time, as is whether the key or
+its name is already registered. Keys cannot be changed in place: a new name or
+key replaces the resource.
+
+Import format: key_id or name (e.g., "42" or "laptop")
+`,
+		Attributes: map[string]schema.Attribute{
+			"key_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: `SSH key numeric identifier.`,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: `Name of the key, unique among the user's keys. Requires replacement if changed.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Required:    true,
+				Description: `SSH public key in OpenSSH format, such as the content of ~/.ssh/id_ed25519.pub. Requires replacement if changed.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"date_created": schema.StringAttribute{
+				Computed:    true,
+				Description: `Timestamp when the key was registered.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used": schema.StringAttribute{
+				Computed:    true,
+				Description: `Timestamp when the key was last used to connect to a studio.`,
+			},
+		},
+	}
+}
+
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `SSH key numeric identifier.`,
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
+
+// ModifyPlan checks a new key with the platform, so a malformed or already
+// registered key fails the plan rather than the apply.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	common.CheckNameAvailable(ctx, r.client, req, resp, nameCheck)
+
+	var publicKey types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("public_key"), &publicKey)...)
+	if resp.Diagnostics.HasError() || publicKey.IsNull() || publicKey.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var current types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("public_key"), &current)...)
+		if resp.Diagnostics.HasError() || current.Equal(publicKey) {
+			return
+		}
+	}
+
+	key := publicKey.ValueString()
+	res, err := r.client.SSHKeys.ValidateSSHKey(ctx, operations.ValidateSSHKeyRequest{Key: &key})
+	if err != nil {
+		// Left for apply to report, like an unreachable name check.
+		return
+	}
+	switch res.StatusCode {
+	case http.StatusBadRequest:
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid SSH Public Key",
+			"The platform does not accept the key. Use an OpenSSH public key such as the content of ~/.ssh/id_ed25519.pub.")
+	case http.StatusConflict:
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "SSH Public Key Already Registered",
+			"The key is already registered. Import the existing seqera_ssh_key instead of creating it again.")
+	}
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.SSHKeys.CreateSSHKey(ctx, shared.CreateSSHKeyRequest{
+		Name:      data.Name.ValueString(),
+		PublicKey: data.PublicKey.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create SSH key", err.Error())
+		return
+	}
+	if res.StatusCode != 200 || res.CreateSSHKeyResponse == nil || res.CreateSSHKeyResponse.SSHKey == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "creating SSH key", res.RawResponse, req.Plan.Raw.Type())
+		return
+	}
+
+	refreshFromKey(&data, res.CreateSSHKeyResponse.SSHKey)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An import by name leaves only the name to go by.
+	if data.KeyID.IsNull() || data.KeyID.IsUnknown() {
+		key, err := FindKey(ctx, r.client, func(key *shared.UserSSHPublicKeyDto) bool {
+			return key.Name != nil && *key.Name == data.Name.ValueString()
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read SSH key", err.Error())
+			return
+		}
+		if key == nil {
+			resp.Diagnostics.AddError("SSH Key Not Found", fmt.Sprintf("No SSH key found with name: %s", data.Name.ValueString()))
+			return
+		}
+		refreshFromKey(&data, key)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	res, err := r.client.SSHKeys.DescribeSSHKey(ctx, operations.DescribeSSHKeyRequest{KeyID: data.KeyID.ValueInt64()})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read SSH key", err.Error())
+		return
+	}
+	if res.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.StatusCode != 200 || res.DescribeSSHKeyResponse == nil || res.DescribeSSHKeyResponse.SSHKey == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "reading SSH key", res.RawResponse, req.State.Raw.Type())
+		return
+	}
+
+	refreshFromKey(&data, res.DescribeSSHKeyResponse.SSHKey)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	// All configurable attributes require replacement, so this should never be called
+	resp.Diagnostics.AddError("Update Not Supported", "SSH keys cannot be updated in place.")
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.SSHKeys.DeleteSSHKey(ctx, operations.DeleteSSHKeyRequest{KeyID: data.KeyID.ValueInt64()})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete SSH key", err.Error())
+		return
+	}
+	if res.StatusCode != 204 && res.StatusCode != 404 {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "deleting SSH key", res.RawResponse, req.State.Raw.Type())
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
+		return
+	}
+
+	// Import format: key_id or name
+	attributes, err := common.ParseImportID("seqera_ssh_key", req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	if id, ok := attributes["key_id"]; ok {
+		// ParseImportID has checked that key_id is a number.
+		keyID, _ := strconv.ParseInt(id, 10, 64)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_id"), keyID)...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), attributes["name"])...)
+}
+
+// FindKey returns the first SSH key of the user accepted by match, or nil if
+// there is none.
+func FindKey(ctx context.Context, client *sdk.Seqera, match func(*shared.UserSSHPublicKeyDto) bool) (*shared.UserSSHPublicKeyDto, error) {
+	keys, err := ListKeys(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for i := range keys {
+		if match(&keys[i]) {
+			return &keys[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ListKeys returns the SSH keys of the user the client authenticates as.
+func ListKeys(ctx context.Context, client *sdk.Seqera) ([]shared.UserSSHPublicKeyDto, error) {
+	res, err := client.SSHKeys.ListSSHKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing SSH keys: %w", err)
+	}
+	if res.StatusCode != 200 || res.ListSSHKeysResponse == nil {
+		return nil, common.UnexpectedStatusErr(ctx, "listing SSH keys", res.RawResponse)
+	}
+	return res.ListSSHKeysResponse.SSHKeys, nil
+}
+
+// refreshFromKey updates the ResourceModel from API response.
+func refreshFromKey(data *ResourceModel, key *shared.UserSSHPublicKeyDto) {
+	data.KeyID = types.Int64PointerValue(key.ID)
+	data.Name = types.StringPointerValue(key.Name)
+	data.PublicKey = types.StringPointerValue(key.PublicKey)
+	data.DateCreated = timeValue(key.DateCreated)
+	data.LastUsed = timeValue(key.LastUsed)
+}
+
+func timeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
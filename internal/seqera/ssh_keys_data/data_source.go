@@ -0,0 +1,107 @@
+// Package ssh_keys_data provides the seqera_ssh_keys data source.
+package ssh_keys_data
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/ssh_key"
+)
+
+var _ datasource.DataSource = &DataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *sdk.Seqera
+}
+
+type keyModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	DateCreated types.String `tfsdk:"date_created"`
+	LastUsed    types.String `tfsdk:"last_used"`
+}
+
+type DataSourceModel struct {
+	Keys []keyModel `tfsdk:"keys"`
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_keys"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the SSH public keys of the user the provider authenticates as.
+
+Wraps ` + "`GET /ssh-keys`" + `. Use to check which keys can connect to studios
+with ` + "`ssh_enabled = true`" + `, including keys registered outside Terraform.`,
+		Attributes: map[string]schema.Attribute{
+			"keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: `SSH keys, in the order returned by the API.`,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":           schema.Int64Attribute{Computed: true, Description: "SSH key numeric identifier."},
+						"name":         schema.StringAttribute{Computed: true, Description: "Name of the key."},
+						"public_key":   schema.StringAttribute{Computed: true, Description: "SSH public key in OpenSSH format."},
+						"date_created": schema.StringAttribute{Computed: true, Description: "RFC3339 timestamp when the key was registered."},
+						"last_used":    schema.StringAttribute{Computed: true, Description: "RFC3339 timestamp when the key was last used, null if never."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		d.client = client
+	}
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := ssh_key.ListKeys(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list SSH keys", err.Error())
+		return
+	}
+
+	data.Keys = make([]keyModel, 0, len(keys))
+	for _, k := range keys {
+		data.Keys = append(data.Keys, keyModel{
+			ID:          types.Int64PointerValue(k.ID),
+			Name:        types.StringPointerValue(k.Name),
+			PublicKey:   types.StringPointerValue(k.PublicKey),
+			DateCreated: rfc3339(k.DateCreated),
+			LastUsed:    rfc3339(k.LastUsed),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func rfc3339(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
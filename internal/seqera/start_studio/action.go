@@ -0,0 +1,125 @@
+// Package start_studio provides the seqera_start_studio action, which starts
+// a stopped studio with its saved configuration and waits until it runs.
+package start_studio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	client *sdk.Seqera
+}
+
+type ActionModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	SessionID   types.String `tfsdk:"session_id"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+}
+
+func (a *Action) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_start_studio"
+}
+
+func (a *Action) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Start a stopped Seqera studio with its saved configuration, and wait until it is running.
+
+Wraps ` + "`PUT /studios/{sessionId}/start`" + `. The ` + "`seqera_studios`" + ` resource is left untouched, so starting a studio this way plans no replacement.
+
+` + "```hcl" + `
+action "seqera_start_studio" "analysis" {
+  config {
+    session_id   = seqera_studios.analysis.session_id
+    wait_timeout = "15m"
+  }
+}
+` + "```" + `
+
+The action fails when the studio ends up errored, fails to build, or is not running within ` + "`wait_timeout`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"session_id": schema.StringAttribute{
+				Required:    true,
+				Description: `Studio session identifier.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"wait_timeout": common.StudioWaitTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *Action) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		a.client = client
+	}
+}
+
+func (a *Action) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(a.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	sessionID := data.SessionID.ValueString()
+	res, err := a.client.Studios.StartDataStudio(ctx, operations.StartDataStudioRequest{
+		SessionID:              sessionID,
+		WorkspaceID:            &workspaceID,
+		DataStudioStartRequest: shared.DataStudioStartRequest{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to start studio", err.Error())
+		return
+	}
+	if res.StatusCode != http.StatusOK || res.DataStudioStartResponse == nil {
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "starting studio", res.RawResponse, req.Config.Raw.Type())
+		return
+	}
+	common.SendProgress(resp, fmt.Sprintf("Requested start of studio %s in workspace %d", sessionID, workspaceID))
+
+	common.WaitForStudioStatus(ctx, a.client, resp, workspaceID, sessionID, shared.DataStudioStatusRunning, res.DataStudioStartResponse.JobSubmitted, data.WaitTimeout)
+}
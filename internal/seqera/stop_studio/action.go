@@ -0,0 +1,204 @@
+// Package stop_studio provides the seqera_stop_studio action, which stops one
+// studio, or every active studio of a workspace, and waits until they are
+// stopped.
+package stop_studio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/operations"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk/models/shared"
+	"github.com/seqeralabs/terraform-provider-seqera/internal/seqera/common"
+)
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	client *sdk.Seqera
+}
+
+type ActionModel struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	SessionID   types.String `tfsdk:"session_id"`
+	All         types.Bool   `tfsdk:"all"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+}
+
+func (a *Action) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stop_studio"
+}
+
+func (a *Action) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Stop a Seqera studio, or every active studio of a workspace, and wait until they are stopped.
+
+Wraps ` + "`PUT /studios/{sessionId}/stop`" + `. The ` + "`seqera_studios`" + ` resource is left untouched, so stopping a studio this way plans no replacement. With ` + "`all`" + `, the action stops the studios that are starting, building or running, for example before replacing the compute environment they run on:
+
+` + "```hcl" + `
+resource "seqera_aws_batch_ce" "main" {
+  # ...
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.seqera_stop_studio.all]
+    }
+  }
+}
+
+action "seqera_stop_studio" "all" {
+  config {
+    all = true
+  }
+}
+` + "```" + `
+
+The action fails when a studio cannot be stopped, ends up errored, or is not stopped within ` + "`wait_timeout`" + `. A studio that cannot be stopped does not keep the others running.`,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"session_id": schema.StringAttribute{
+				Optional:    true,
+				Description: `Studio session identifier. Exactly one of session_id or all must be set.`,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("all")),
+				},
+			},
+			"all": schema.BoolAttribute{
+				Optional:    true,
+				Description: `Stop every studio of the workspace that is starting, building or running. Exactly one of session_id or all must be set.`,
+			},
+			"wait_timeout": common.StudioWaitTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *Action) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, diags := common.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		a.client = client
+	}
+}
+
+func (a *Action) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, ok := common.WorkspaceOrDefault(a.client, data.WorkspaceID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_id"),
+			"Missing Workspace",
+			"workspace_id must be set when the provider has no default_workspace.",
+		)
+		return
+	}
+
+	var sessionIDs []string
+	switch {
+	case !data.SessionID.IsNull():
+		sessionIDs = []string{data.SessionID.ValueString()}
+	case data.All.ValueBool():
+		var err error
+		sessionIDs, err = a.activeStudios(ctx, workspaceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list studios", err.Error())
+			return
+		}
+		common.SendProgress(resp, fmt.Sprintf("Stopping %d active studios in workspace %d", len(sessionIDs), workspaceID))
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("all"),
+			"Nothing To Stop",
+			"Set session_id, or set all to true to stop every active studio of the workspace.",
+		)
+		return
+	}
+
+	// A failed stop does not keep the other studios running: every stop is
+	// requested, and the ones the platform took are waited on.
+	submitted := map[string]bool{}
+	var stopped []string
+	for _, sessionID := range sessionIDs {
+		res, err := a.client.Studios.StopDataStudio(ctx, operations.StopDataStudioRequest{
+			SessionID:   sessionID,
+			WorkspaceID: &workspaceID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to stop studio", fmt.Sprintf("stopping studio %s: %s", sessionID, err))
+			continue
+		}
+		if res.StatusCode != http.StatusOK || res.DataStudioStopResponse == nil {
+			common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "stopping studio "+sessionID, res.RawResponse, req.Config.Raw.Type())
+			continue
+		}
+		submitted[sessionID] = res.DataStudioStopResponse.JobSubmitted
+		stopped = append(stopped, sessionID)
+		common.SendProgress(resp, fmt.Sprintf("Requested stop of studio %s in workspace %d", sessionID, workspaceID))
+	}
+
+	// Every stop is requested before waiting, so the studios shut down in
+	// parallel.
+	for _, sessionID := range stopped {
+		common.WaitForStudioStatus(ctx, a.client, resp, workspaceID, sessionID, shared.DataStudioStatusStopped, submitted[sessionID], data.WaitTimeout)
+	}
+}
+
+// activeStudios lists the studios of the workspace that are starting,
+// building or running.
+func (a *Action) activeStudios(ctx context.Context, workspaceID int64) ([]string, error) {
+	var sessionIDs []string
+	_, err := common.PaginatedSearch(ctx,
+		func(ctx context.Context, max, offset int) ([]common.StudioStatus, int64, error) {
+			res, err := a.client.Studios.ListDataStudios(ctx, operations.ListDataStudiosRequest{
+				WorkspaceID: &workspaceID,
+				Max:         &max,
+				Offset:      &offset,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("listing studios: %w", err)
+			}
+			if res.StatusCode != http.StatusOK || res.RawResponse == nil {
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing studios", res.RawResponse)
+			}
+			return common.DecodeStudioList(res.RawResponse.Body)
+		},
+		// Collect every active studio rather than stopping at a match.
+		func(studio *common.StudioStatus) bool {
+			switch studio.Status {
+			case shared.DataStudioStatusStarting, shared.DataStudioStatusBuilding, shared.DataStudioStatusRunning:
+				sessionIDs = append(sessionIDs, studio.SessionID)
+			}
+			return false
+		},
+	)
+	return sessionIDs, err
+}
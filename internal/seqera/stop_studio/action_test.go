@@ -0,0 +1,76 @@
+package stop_studio
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/sdk"
+)
+
+// A studio failing to stop does not leave the others running, and the ones
+// that were stopped are still waited on.
+func TestInvokeAllKeepsStopping(t *testing.T) {
+	var mu sync.Mutex
+	var stops, describes []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		sessionID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/studios/"), "/stop")
+		switch {
+		case r.URL.Path == "/studios":
+			studios := []map[string]any{}
+			for _, id := range []string{"a", "b", "c"} {
+				studios = append(studios, map[string]any{"sessionId": id, "statusInfo": map[string]any{"status": "running"}})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"studios": studios, "totalSize": len(studios)})
+		case strings.HasSuffix(r.URL.Path, "/stop"):
+			stops = append(stops, sessionID)
+			if sessionID == "b" {
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(map[string]any{"message": "studio is busy"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"jobSubmitted": true, "sessionId": sessionID})
+		default:
+			describes = append(describes, sessionID)
+			_ = json.NewEncoder(w).Encode(map[string]any{"sessionId": sessionID, "statusInfo": map[string]any{"status": "stopped"}})
+		}
+	}))
+	defer api.Close()
+
+	ctx := context.Background()
+	a := &Action{client: sdk.New(sdk.WithServerURL(api.URL))}
+	var schema action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schema)
+	objectType := schema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{Schema: schema.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"workspace_id": tftypes.NewValue(tftypes.Number, 1),
+		"session_id":   tftypes.NewValue(tftypes.String, nil),
+		"all":          tftypes.NewValue(tftypes.Bool, true),
+		"wait_timeout": tftypes.NewValue(tftypes.String, nil),
+	})}
+
+	resp := &action.InvokeResponse{}
+	a.Invoke(ctx, action.InvokeRequest{Config: config}, resp)
+
+	if !slices.Equal(stops, []string{"a", "b", "c"}) {
+		t.Errorf("expected every studio to be stopped, got %v", stops)
+	}
+	if !slices.Equal(describes, []string{"a", "c"}) {
+		t.Errorf("expected the stopped studios to be waited on, got %v", describes)
+	}
+	if resp.Diagnostics.ErrorsCount() != 1 || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "stopping studio b") {
+		t.Errorf("expected one error for studio b, got %v", resp.Diagnostics)
+	}
+}
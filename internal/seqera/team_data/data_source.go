@@ -98,7 +98,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 	if listRes.StatusCode != 200 {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "listing organization teams", listRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "listing organization teams", listRes.RawResponse, req.Config.Raw.Type())
 		return
 	}
 	if listRes.ListTeamResponse == nil {
@@ -5,12 +5,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,8 +27,12 @@ import (
 var (
 	_ resource.Resource                = &Resource{}
 	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
 )
 
+// identityAttributes identifies a team member by organization, team and email.
+var identityAttributes = common.IdentityAttributes{"org_id": "org_id", "team_id": "team_id", "email": "email"}
+
 func NewResource() resource.Resource {
 	return &Resource{}
 }
@@ -161,6 +165,25 @@ Import format: org_id/team_id/email (e.g., "12345/67890/user@example.com")
 	}
 }
 
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Organization numeric identifier.`,
+			},
+			"team_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Team numeric identifier.`,
+			},
+			"email": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       `Email address of the member.`,
+			},
+		},
+	}
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, diags := common.ConfigureClient(req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -170,6 +193,8 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -218,7 +243,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 	if createRes.StatusCode != 200 || createRes.AddTeamMemberResponse == nil || createRes.AddTeamMemberResponse.Member == nil {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "adding team member", createRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "adding team member", createRes.RawResponse, req.Plan.Raw.Type())
 		return
 	}
 
@@ -227,6 +252,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -255,6 +282,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// All configurable attributes require replacement, so this should never be called
 	resp.Diagnostics.AddError("Update Not Supported", "Team member resources cannot be updated in place.")
 }
@@ -276,42 +305,27 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 	if deleteRes.StatusCode != 204 && deleteRes.StatusCode != 404 {
-		resp.Diagnostics.AddError("Failed to delete team member", common.DebugResponse(deleteRes.RawResponse))
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "deleting team member", deleteRes.RawResponse, req.State.Raw.Type())
 	}
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: org_id/team_id/email
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected format: org_id/team_id/email, got: %s", req.ID),
-		)
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
 		return
 	}
 
-	orgID, err := strconv.ParseInt(parts[0], 10, 64)
+	// Import format: org_id/team_id/email
+	attributes, err := common.ParseImportID("seqera_team_member", req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid org_id",
-			fmt.Sprintf("org_id must be a number, got: %s", parts[0]),
-		)
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
-
-	teamID, err := strconv.ParseInt(parts[1], 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid team_id",
-			fmt.Sprintf("team_id must be a number, got: %s", parts[1]),
-		)
-		return
+	// ParseImportID has checked that both IDs are numbers.
+	for _, name := range []string{"org_id", "team_id"} {
+		value, _ := strconv.ParseInt(attributes[name], 10, 64)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
 	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), attributes["email"])...)
 }
 
 // findOrgMember looks up an organization member by member_id.
@@ -330,7 +344,7 @@ func (r *Resource) findOrgMember(ctx context.Context, orgID, memberID int64) (*s
 				return nil, 0, err
 			}
 			if listRes.StatusCode != 200 {
-				return nil, 0, common.UnexpectedStatusErr("listing organization members", listRes.RawResponse)
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing organization members", listRes.RawResponse)
 			}
 			if listRes.ListMembersResponse == nil {
 				return nil, 0, fmt.Errorf("empty response listing organization members")
@@ -380,7 +394,7 @@ func (r *Resource) findMember(ctx context.Context, orgID, teamID int64, email st
 				return nil, 0, err
 			}
 			if listRes.StatusCode != 200 {
-				return nil, 0, common.UnexpectedStatusErr("listing team members", listRes.RawResponse)
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing team members", listRes.RawResponse)
 			}
 			if listRes.ListMembersResponse == nil {
 				return nil, 0, fmt.Errorf("empty response listing team members")
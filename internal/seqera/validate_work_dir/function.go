@@ -0,0 +1,116 @@
+// Package validate_work_dir provides the provider::seqera::validate_work_dir
+// function, applying the provider's work_dir rules to a compute environment
+// platform.
+package validate_work_dir
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/seqeralabs/terraform-provider-seqera/internal/validators/stringvalidators"
+)
+
+var _ function.Function = &Function{}
+
+// platformPrefixes are the work directory prefixes each compute environment
+// platform accepts: its cloud storage, and "/" where a shared file system
+// can be mounted.
+var platformPrefixes = map[string][]string{
+	"aws-batch":       {"s3://", "/"},
+	"aws-cloud":       {"s3://"},
+	"eks-platform":    {"s3://", "/"},
+	"google-batch":    {"gs://", "/"},
+	"google-cloud":    {"gs://"},
+	"gke-platform":    {"gs://", "/"},
+	"azure-batch":     {"az://", "/"},
+	"azure-cloud":     {"az://"},
+	"k8s-platform":    {"/"},
+	"slurm-platform":  {"/"},
+	"lsf-platform":    {"/"},
+	"uge-platform":    {"/"},
+	"altair-platform": {"/"},
+	"moab-platform":   {"/"},
+	"local-platform":  {"/"},
+}
+
+func NewFunction() function.Function {
+	return &Function{}
+}
+
+type Function struct{}
+
+func (f *Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_work_dir"
+}
+
+func (f *Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check a Nextflow work directory for a compute environment platform",
+		MarkdownDescription: `Return whether ` + "`path`" + ` is a valid Nextflow work directory for a compute environment ` + "`platform`" + `, following the same rules as the provider's ` + "`work_dir`" + ` attributes: a cloud storage URI with a bucket or container name, or an absolute path, without a trailing slash. The URI scheme must also match the platform, such as ` + "`s3://`" + ` for ` + "`aws-batch`" + `; HPC and Kubernetes platforms take absolute paths only.
+
+` + "```hcl" + `
+variable "work_dir" {
+  type = string
+
+  validation {
+    condition     = provider::seqera::validate_work_dir("aws-batch", var.work_dir)
+    error_message = "work_dir must be an S3 URI or an absolute path, without a trailing slash."
+  }
+}
+` + "```",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "platform",
+				Description: fmt.Sprintf("Compute environment platform, one of: %s.", strings.Join(platforms(), ", ")),
+			},
+			function.StringParameter{
+				Name:        "path",
+				Description: `Work directory to check.`,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var platform, path string
+	resp.Error = req.Arguments.Get(ctx, &platform, &path)
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes, ok := platformPrefixes[platform]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unknown platform %q, expected one of: %s", platform, strings.Join(platforms(), ", ")))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, isValid(prefixes, path))
+}
+
+func isValid(prefixes []string, path string) bool {
+	if path == "" {
+		return false
+	}
+	if summary, _ := stringvalidators.CheckWorkDir(path); summary != "" {
+		return false
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func platforms() []string {
+	names := make([]string, 0, len(platformPrefixes))
+	for name := range platformPrefixes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
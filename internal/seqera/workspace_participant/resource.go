@@ -5,12 +5,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,8 +28,20 @@ import (
 var (
 	_ resource.Resource                = &Resource{}
 	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
 )
 
+// identityAttributes identifies a participant by workspace and one of email,
+// member_id or team_id.
+var identityAttributes = common.IdentityAttributes{
+	"org_id":       "org_id",
+	"workspace_id": "workspace_id",
+	"email":        "email",
+	"member_id":    "member_id",
+	"team_id":      "team_id",
+}
+
 func NewResource() resource.Resource {
 	return &Resource{}
 }
@@ -88,11 +100,12 @@ Import formats:
 				Description: `Organization numeric identifier.`,
 			},
 			"workspace_id": schema.Int64Attribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
 				},
-				Description: `Workspace numeric identifier.`,
+				Description: `Workspace numeric identifier. Defaults to the provider default_workspace when omitted.`,
 			},
 			"member_id": schema.Int64Attribute{
 				Optional: true,
@@ -193,6 +206,33 @@ Import formats:
 	}
 }
 
+func (r *Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"org_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Organization numeric identifier.`,
+			},
+			"workspace_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       `Workspace numeric identifier.`,
+			},
+			"email": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       `Email address of a user participant.`,
+			},
+			"member_id": identityschema.Int64Attribute{
+				OptionalForImport: true,
+				Description:       `Organization member numeric identifier of a user participant.`,
+			},
+			"team_id": identityschema.Int64Attribute{
+				OptionalForImport: true,
+				Description:       `Team numeric identifier of a team participant.`,
+			},
+		},
+	}
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, diags := common.ConfigureClient(req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -201,7 +241,15 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 }
 
+// ModifyPlan fills workspace_id from the provider default_workspace when it
+// is omitted.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ApplyDefaultWorkspace(ctx, r.client, req, resp, true)
+}
+
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -273,7 +321,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 	if createRes.StatusCode != 200 || createRes.AddParticipantResponse == nil || createRes.AddParticipantResponse.Participant == nil {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "adding workspace participant", createRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "adding workspace participant", createRes.RawResponse, req.Plan.Raw.Type())
 		return
 	}
 
@@ -311,7 +359,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 			return
 		}
 		if updateRes.StatusCode != 204 {
-			resp.Diagnostics.AddError("Failed to update participant role", common.DebugResponse(updateRes.RawResponse))
+			common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "updating participant role", updateRes.RawResponse, req.Plan.Raw.Type())
 			return
 		}
 	}
@@ -338,6 +386,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var data ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -381,6 +431,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer common.SetIdentityFromState(ctx, identityAttributes, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var data ResourceModel
 	var state ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -405,7 +457,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 	if updateRes.StatusCode != 204 {
-		resp.Diagnostics.AddError("Failed to update participant role", common.DebugResponse(updateRes.RawResponse))
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "updating participant role", updateRes.RawResponse, req.Plan.Raw.Type())
 		return
 	}
 
@@ -453,75 +505,32 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 	if deleteRes.StatusCode != 204 && deleteRes.StatusCode != 404 {
-		resp.Diagnostics.AddError("Failed to delete workspace participant", common.DebugResponse(deleteRes.RawResponse))
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "deleting workspace participant", deleteRes.RawResponse, req.State.Raw.Type())
 	}
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if common.ImportStateFromIdentity(ctx, r.client, identityAttributes, req, resp) {
+		return
+	}
+
 	// Import formats:
 	// - org_id/workspace_id/email (e.g., "12345/67890/user@example.com")
 	// - org_id/workspace_id/team:team_id (e.g., "12345/67890/team:7405043533023")
 	// - org_id/workspace_id/member:member_id (e.g., "12345/67890/member:98765")
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected format: org_id/workspace_id/email or org_id/workspace_id/team:team_id or org_id/workspace_id/member:member_id, got: %s", req.ID),
-		)
-		return
-	}
-
-	orgID, err := strconv.ParseInt(parts[0], 10, 64)
+	attributes, err := common.ParseImportID("seqera_workspace_participant", req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid org_id",
-			fmt.Sprintf("org_id must be a number, got: %s", parts[0]),
-		)
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
-
-	workspaceID, err := strconv.ParseInt(parts[1], 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid workspace_id",
-			fmt.Sprintf("workspace_id must be a number, got: %s", parts[1]),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
-
-	// Determine import type based on third part
-	identifier := parts[2]
-
-	if strings.HasPrefix(identifier, "team:") {
-		// Import by team_id
-		teamIDStr := strings.TrimPrefix(identifier, "team:")
-		teamID, err := strconv.ParseInt(teamIDStr, 10, 64)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Invalid team_id",
-				fmt.Sprintf("team_id must be a number, got: %s", teamIDStr),
-			)
-			return
+	// ParseImportID has checked that everything but the email is a number.
+	for name, value := range attributes {
+		if name == "email" {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+			continue
 		}
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
-	} else if strings.HasPrefix(identifier, "member:") {
-		// Import by member_id
-		memberIDStr := strings.TrimPrefix(identifier, "member:")
-		memberID, err := strconv.ParseInt(memberIDStr, 10, 64)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Invalid member_id",
-				fmt.Sprintf("member_id must be a number, got: %s", memberIDStr),
-			)
-			return
-		}
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), memberID)...)
-	} else {
-		// Import by email (default)
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), identifier)...)
+		id, _ := strconv.ParseInt(value, 10, 64)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), id)...)
 	}
 }
 
@@ -557,7 +566,7 @@ func (r *Resource) findParticipant(ctx context.Context, orgID, workspaceID int64
 				return nil, 0, err
 			}
 			if listRes.StatusCode != 200 {
-				return nil, 0, common.UnexpectedStatusErr("listing workspace participants", listRes.RawResponse)
+				return nil, 0, common.UnexpectedStatusErr(ctx, "listing workspace participants", listRes.RawResponse)
 			}
 			if listRes.ListParticipantsResponse == nil {
 				return nil, 0, fmt.Errorf("empty response listing workspace participants")
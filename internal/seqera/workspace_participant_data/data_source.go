@@ -110,7 +110,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 	if listRes.StatusCode != 200 {
-		common.AddUnexpectedStatus(&resp.Diagnostics, "listing workspace participants", listRes.RawResponse)
+		common.AddUnexpectedStatus(ctx, &resp.Diagnostics, "listing workspace participants", listRes.RawResponse, req.Config.Raw.Type())
 		return
 	}
 	if listRes.ListParticipantsResponse == nil {
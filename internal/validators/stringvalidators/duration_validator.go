@@ -0,0 +1,53 @@
+package stringvalidators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = StringDurationValidatorValidator{}
+
+type StringDurationValidatorValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v StringDurationValidatorValidator) Description(_ context.Context) string {
+	return "value must be a non-negative Go duration string such as \"500ms\", \"30s\" or \"5m\""
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v StringDurationValidatorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v StringDurationValidatorValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a duration string such as \"500ms\", \"30s\" or \"5m\", got %q: %s", value, err),
+		)
+		return
+	}
+
+	if d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Duration must not be negative, got %q", value),
+		)
+	}
+}
+
+func DurationValidator() validator.String {
+	return StringDurationValidatorValidator{}
+}
@@ -25,9 +25,17 @@ func (v StringWorkDirFormatValidatorValidator) ValidateString(ctx context.Contex
 		return
 	}
 
-	workDir := req.ConfigValue.ValueString()
+	if summary, detail := CheckWorkDir(req.ConfigValue.ValueString()); summary != "" {
+		resp.Diagnostics.AddAttributeError(req.Path, summary, detail)
+	}
+}
+
+// CheckWorkDir returns the summary and detail of the first problem with
+// workDir, or empty strings if there is none. It is shared with the
+// validate_work_dir provider function.
+func CheckWorkDir(workDir string) (summary, detail string) {
 	if workDir == "" {
-		return
+		return "", ""
 	}
 
 	// Validate that work_dir starts with a valid prefix
@@ -41,31 +49,21 @@ func (v StringWorkDirFormatValidatorValidator) ValidateString(ctx context.Contex
 	}
 
 	if !hasValidPrefix {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Invalid Working Directory",
-			fmt.Sprintf(
-				"work_dir must start with a valid cloud storage prefix (s3://, gs://, az://) or be an absolute local path (/)."+
-					" Got: %q", workDir,
-			),
+		return "Invalid Working Directory", fmt.Sprintf(
+			"work_dir must start with a valid cloud storage prefix (s3://, gs://, az://) or be an absolute local path (/)."+
+				" Got: %q", workDir,
 		)
-		return
 	}
 
 	// Check for trailing slash — the API strips trailing slashes at launch time,
 	// which causes plan diffs if the stored value has one.
 	// Only check paths longer than "/" itself.
 	if len(workDir) > 1 && strings.HasSuffix(workDir, "/") {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Trailing Slash in Working Directory",
-			fmt.Sprintf(
-				"work_dir should not end with a trailing slash. The Seqera API strips trailing slashes,"+
-					" which would cause unexpected plan diffs. Please remove the trailing slash: %q → %q",
-				workDir, strings.TrimRight(workDir, "/"),
-			),
+		return "Trailing Slash in Working Directory", fmt.Sprintf(
+			"work_dir should not end with a trailing slash. The Seqera API strips trailing slashes,"+
+				" which would cause unexpected plan diffs. Please remove the trailing slash: %q → %q",
+			workDir, strings.TrimRight(workDir, "/"),
 		)
-		return
 	}
 
 	// For cloud storage URIs, validate that there's a bucket/container name after the prefix
@@ -78,19 +76,15 @@ func (v StringWorkDirFormatValidatorValidator) ValidateString(ctx context.Contex
 		if strings.HasPrefix(workDir, prefix) {
 			remainder := workDir[len(prefix):]
 			if remainder == "" {
-				resp.Diagnostics.AddAttributeError(
-					req.Path,
-					"Missing Bucket/Container Name",
-					fmt.Sprintf(
-						"work_dir with prefix %q must include a %s name (e.g., %sexample-bucket/work). Got: %q",
-						prefix, name, prefix, workDir,
-					),
+				return "Missing Bucket/Container Name", fmt.Sprintf(
+					"work_dir with prefix %q must include a %s name (e.g., %sexample-bucket/work). Got: %q",
+					prefix, name, prefix, workDir,
 				)
-				return
 			}
 			break
 		}
 	}
+	return "", ""
 }
 
 func WorkDirFormatValidator() validator.String {
@@ -34,7 +34,7 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err := providerserver.Serve(context.Background(), provider.NewExtended(version), opts)
 
 	if err != nil {
 		log.Fatal(err.Error())